@@ -0,0 +1,129 @@
+package errific
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Overflow is the Count key used once an Aggregator has no free slots.
+const Overflow = "_overflow"
+
+// Count is the number of errors aggregated for a code.
+type Count struct {
+	Code     string    `json:"code"`
+	Category Category  `json:"category,omitempty"`
+	Count    uint64    `json:"count"`
+	First    time.Time `json:"first"`
+	Last     time.Time `json:"last"`
+}
+
+// Aggregator counts errors by code in a bounded set of pre-allocated slots.
+// Codes seen after all slots are taken are counted under Overflow,
+// so high-cardinality codes cannot grow memory.
+// An Aggregator is safe for concurrent use.
+//
+//	var aggregator = errific.NewAggregator(256)
+//
+//	aggregator.Record(err)
+type Aggregator struct {
+	mu    sync.Mutex
+	slots []Count
+	codes codeSlots
+}
+
+// NewAggregator returns an Aggregator with slots for size distinct codes,
+// plus one slot for Overflow.
+func NewAggregator(size int) *Aggregator {
+	if size < 1 {
+		size = 1
+	}
+	return &Aggregator{
+		slots: make([]Count, 0, size+1),
+		codes: newCodeSlots(size),
+	}
+}
+
+// Record counts err under its code. Nil errors are ignored.
+func (a *Aggregator) Record(err error) {
+	if err == nil {
+		return
+	}
+	a.Add(NewRecord(err))
+}
+
+// Add counts r under its code.
+func (a *Aggregator) Add(r Record) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	i, code, added := a.codes.slot(r.Code)
+	if added {
+		a.slots = append(a.slots, Count{Code: code, Category: r.Category, First: r.Time})
+	}
+
+	a.slots[i].Count++
+	a.slots[i].Last = r.Time
+}
+
+// Counts returns a copy of the counts sorted by count, highest first.
+func (a *Aggregator) Counts() []Count {
+	a.mu.Lock()
+	counts := append([]Count(nil), a.slots...)
+	a.mu.Unlock()
+
+	sort.SliceStable(counts, func(i, j int) bool {
+		return counts[i].Count > counts[j].Count
+	})
+	return counts
+}
+
+// Reset clears all counts, keeping the allocated slots.
+func (a *Aggregator) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.slots = a.slots[:0]
+	a.codes.reset()
+}
+
+// codeSlots assigns codes to at most size slot indexes, plus one for
+// Overflow, shared by the codes seen once all slots are taken.
+type codeSlots struct {
+	size     int
+	index    map[string]int
+	overflow bool
+}
+
+func newCodeSlots(size int) codeSlots {
+	return codeSlots{size: size, index: make(map[string]int, size+1)}
+}
+
+// slot returns the slot index of code and the code it is kept under,
+// reporting whether the slot was added and must be appended by the caller.
+func (s *codeSlots) slot(code string) (i int, key string, added bool) {
+	if i, ok := s.index[code]; ok {
+		return i, code, false
+	}
+
+	codes := len(s.index)
+	if s.overflow {
+		codes--
+	}
+	if codes >= s.size {
+		code = Overflow
+		if i, ok := s.index[code]; ok {
+			return i, code, false
+		}
+		s.overflow = true
+	}
+
+	i = len(s.index)
+	s.index[code] = i
+	return i, code, true
+}
+
+// reset clears all slots, keeping the allocated index.
+func (s *codeSlots) reset() {
+	clear(s.index)
+	s.overflow = false
+}
@@ -0,0 +1,35 @@
+package errific_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/leefernandes/errific"
+)
+
+// TestAggregatorOverflow asserts an Aggregator keeps at most size codes
+// plus Overflow, however many codes are recorded.
+func TestAggregatorOverflow(t *testing.T) {
+	const size = 4
+	aggregator := NewAggregator(size)
+
+	for i := range 5 * size {
+		aggregator.Add(Record{Code: fmt.Sprintf("CODE_%03d", i)})
+	}
+
+	counts := aggregator.Counts()
+	if len(counts) != size+1 {
+		t.Fatalf("got %d counts, want %d", len(counts), size+1)
+	}
+	if counts[0].Code != Overflow || counts[0].Count != 4*size {
+		t.Errorf("got %s counted %d, want %s counted %d", counts[0].Code, counts[0].Count, Overflow, 4*size)
+	}
+
+	aggregator.Reset()
+	for i := range 5 * size {
+		aggregator.Add(Record{Code: fmt.Sprintf("CODE_%03d", i)})
+	}
+	if got := len(aggregator.Counts()); got != size+1 {
+		t.Errorf("got %d counts after Reset, want %d", got, size+1)
+	}
+}
@@ -0,0 +1,148 @@
+// Package airbrake formats errific errors as Airbrake notices,
+// which Errbit also accepts.
+package airbrake
+
+import (
+	"log/slog"
+	"os"
+	"runtime"
+
+	"github.com/leefernandes/errific"
+)
+
+// Notice is an Airbrake notice of the notices API v3.
+type Notice struct {
+	Errors      []Error        `json:"errors"`
+	Context     Context        `json:"context"`
+	Environment map[string]any `json:"environment,omitempty"`
+	Session     map[string]any `json:"session,omitempty"`
+	Params      map[string]any `json:"params,omitempty"`
+}
+
+// Error is the type, message, and backtrace of an error of a Notice.
+type Error struct {
+	Type      string      `json:"type"`
+	Message   string      `json:"message"`
+	Backtrace []Backtrace `json:"backtrace"`
+}
+
+// Backtrace is a frame of an Error backtrace.
+type Backtrace struct {
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Function string `json:"function,omitempty"`
+}
+
+// Context is the context of a Notice.
+type Context struct {
+	Notifier    Notifier `json:"notifier"`
+	Environment string   `json:"environment,omitempty"`
+	Component   string   `json:"component,omitempty"`
+	Version     string   `json:"version,omitempty"`
+	Hostname    string   `json:"hostname,omitempty"`
+	OS          string   `json:"os"`
+	Language    string   `json:"language"`
+	Severity    string   `json:"severity"`
+}
+
+// Notifier identifies the notifier of a Notice.
+type Notifier struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
+
+// NewNotice returns the Notice of err.
+//
+// The error type is the code of err, or its category, and the backtrace
+// is from errific.Frames. The severity is mapped from errific.LogLevel, and
+// the environment, component, and version from the errific.ServiceIdentity.
+// Params are the context of err, the environment section its labels, and
+// the session section its correlation ID, request ID, and tenant.
+//
+//	body, _ := json.Marshal(airbrake.NewNotice(err))
+//	http.Post("https://api.airbrake.io/api/v3/projects/"+id+"/notices?key="+key, "application/json", bytes.NewReader(body))
+func NewNotice(err error) Notice {
+	info := errific.ResolveChain(err)
+	n := Notice{
+		Errors: []Error{{
+			Type:      info.Code,
+			Message:   err.Error(),
+			Backtrace: []Backtrace{},
+		}},
+		Context: Context{
+			Notifier: Notifier{
+				Name:    "errific",
+				Version: "1",
+				URL:     "https://github.com/leefernandes/errific",
+			},
+			OS:       runtime.GOOS,
+			Language: runtime.Version(),
+			Severity: Severity(errific.LogLevel(err)),
+		},
+	}
+
+	e := &n.Errors[0]
+	if e.Type == "" {
+		e.Type = string(info.Category)
+	}
+	if e.Type == "" {
+		e.Type = "error"
+	}
+	for _, frame := range errific.Frames(err) {
+		e.Backtrace = append(e.Backtrace, Backtrace{
+			File:     frame.File,
+			Line:     frame.Line,
+			Function: frame.Function,
+		})
+	}
+
+	n.Context.Hostname, _ = os.Hostname()
+	if s := info.Service; s != nil {
+		n.Context.Environment = s.Env
+		n.Context.Component = s.Name
+		n.Context.Version = s.Version
+	}
+
+	if len(info.Context) > 0 {
+		n.Params = info.Context
+	}
+	if len(info.Labels) > 0 {
+		n.Environment = make(map[string]any, len(info.Labels))
+		for k, v := range info.Labels {
+			n.Environment[k] = v
+		}
+	}
+	session := map[string]any{}
+	for k, v := range map[string]string{
+		"correlation_id": info.CorrelationID,
+		"request_id":     info.RequestID,
+		"tenant":         info.Tenant,
+	} {
+		if v != "" {
+			session[k] = v
+		}
+	}
+	if len(session) > 0 {
+		n.Session = session
+	}
+
+	return n
+}
+
+// Severity returns the Airbrake severity of level:
+// critical, error, warning, info, or debug.
+func Severity(level slog.Level) string {
+	switch {
+	case level >= errific.LevelCritical:
+		return "critical"
+	case level >= slog.LevelError:
+		return "error"
+	case level >= slog.LevelWarn:
+		return "warning"
+	case level >= slog.LevelInfo:
+		return "info"
+	default:
+		return "debug"
+	}
+}
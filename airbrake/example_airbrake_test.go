@@ -0,0 +1,32 @@
+package airbrake_test
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/airbrake"
+)
+
+func ExampleNewNotice() {
+	errific.Configure(errific.ServiceIdentity("things", "v1.2.3", "prod"))
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithRequestID("r-123").
+		WithLabel("region", "us-east-1").
+		WithContext(map[string]any{"thing_id": 42})
+
+	n := airbrake.NewNotice(err)
+	e := n.Errors[0]
+	fmt.Println(e.Type, e.Message[:20], e.Backtrace[0].Function)
+	fmt.Println(n.Context.Severity, n.Context.Environment, n.Context.Component, n.Context.Version)
+	fmt.Println(n.Params, n.Environment, n.Session)
+
+	// Output:
+	// QUERY_001 error querying thing ExampleNewNotice
+	// error prod things v1.2.3
+	// map[thing_id:42] map[region:us-east-1] map[request_id:r-123]
+}
@@ -0,0 +1,173 @@
+package errific
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// archiveBacklog is the number of batches an Archiver keeps while
+// uploads fail.
+const archiveBacklog = 10
+
+// Uploader writes objects to S3, GCS, or compatible object storage.
+type Uploader interface {
+	Upload(ctx context.Context, key string, body []byte) error
+}
+
+// UploaderFunc adapts a function to an Uploader.
+type UploaderFunc func(ctx context.Context, key string, body []byte) error
+
+// Upload calls f.
+func (f UploaderFunc) Upload(ctx context.Context, key string, body []byte) error {
+	return f(ctx, key, body)
+}
+
+// Archiver batches errors encoded as JSON into gzip compressed JSONL
+// objects written with an Uploader, for long-term error archives.
+// While uploads fail, an Archiver keeps up to ten batches of errors,
+// dropping the oldest.
+// An Archiver is safe for concurrent use.
+//
+//	archiver := errific.NewArchiver(uploader, "errors/", 1000)
+//	go archiver.Run(ctx, time.Minute, nil)
+//
+//	archiver.Record(ctx, err)
+type Archiver struct {
+	uploader  Uploader
+	prefix    string
+	batchSize int
+
+	mu      sync.Mutex
+	lines   [][]byte
+	seq     uint64
+	failing bool
+	dropped atomic.Uint64
+}
+
+// NewArchiver returns an Archiver uploading objects keyed under prefix,
+// flushing when batchSize errors are recorded. Batches default to 1000.
+func NewArchiver(uploader Uploader, prefix string, batchSize int) *Archiver {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	return &Archiver{uploader: uploader, prefix: prefix, batchSize: batchSize}
+}
+
+// Record encodes err as JSON in the current batch, and flushes the batch
+// when it is full. After an upload fails, Record only batches errors,
+// leaving retries to Flush, such as by Run. Nil errors are ignored.
+func (a *Archiver) Record(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	line, err := appendErrorJSON(nil, err)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	a.lines = append(a.lines, line)
+	a.trim()
+	full := len(a.lines) >= a.batchSize && !a.failing
+	a.mu.Unlock()
+
+	if full {
+		return a.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush uploads the recorded errors as one object keyed
+// <prefix>YYYY/MM/DD/<unix nanoseconds>-<sequence>.jsonl.gz.
+// The errors are kept when the upload fails, up to ten batches.
+func (a *Archiver) Flush(ctx context.Context) error {
+	a.mu.Lock()
+	lines := a.lines
+	a.lines = nil
+	a.seq++
+	seq := a.seq
+	a.mu.Unlock()
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	for _, line := range lines {
+		zw.Write(line)
+		zw.Write([]byte{'\n'})
+	}
+	if err := zw.Close(); err != nil {
+		a.restore(lines)
+		return err
+	}
+
+	now := time.Now().UTC()
+	key := fmt.Sprintf("%s%s/%d-%d.jsonl.gz", a.prefix, now.Format("2006/01/02"), now.UnixNano(), seq)
+	if err := a.uploader.Upload(ctx, key, buf.Bytes()); err != nil {
+		a.restore(lines)
+		return err
+	}
+
+	a.mu.Lock()
+	a.failing = false
+	a.mu.Unlock()
+	return nil
+}
+
+// restore puts lines that failed to upload back before the recorded errors.
+func (a *Archiver) restore(lines [][]byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.failing = true
+	a.lines = append(lines, a.lines...)
+	a.trim()
+}
+
+// trim drops the oldest lines over the backlog. a.mu must be held.
+func (a *Archiver) trim() {
+	excess := len(a.lines) - archiveBacklog*a.batchSize
+	if excess <= 0 {
+		return
+	}
+	n := copy(a.lines, a.lines[excess:])
+	clear(a.lines[n:])
+	a.lines = a.lines[:n]
+	a.dropped.Add(uint64(excess))
+}
+
+// Dropped returns the number of errors discarded while uploads failed.
+func (a *Archiver) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+// Run calls Flush every interval until ctx is done, then flushes
+// once more with a background context. Flush errors are passed to
+// onError, if not nil.
+func (a *Archiver) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	flush := func(ctx context.Context) {
+		if err := a.Flush(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			flush(context.Background())
+			return
+		case <-ticker.C:
+			flush(ctx)
+		}
+	}
+}
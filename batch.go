@@ -0,0 +1,449 @@
+package errific
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// MarshalErrors encodes errs as a JSON array of errors encoded like
+// MarshalJSON, into one buffer without reflection per error, for bulk
+// export paths. Nil errors are skipped.
+//
+// Context values other than strings, booleans, numbers, and nil are
+// encoded with encoding/json, and replaced with placeholders like
+// MarshalJSON if they fail to encode. With MaxJSONSize or CompressContext,
+// errors are encoded with MarshalJSON instead.
+//
+//	body, err := errific.MarshalErrors(errs)
+func MarshalErrors(errs []error) ([]byte, error) {
+	b := []byte{'['}
+	n := 0
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if n > 0 {
+			b = append(b, ',')
+		}
+		n++
+
+		var marshalErr error
+		if b, marshalErr = appendErrorJSON(b, err); marshalErr != nil {
+			return nil, marshalErr
+		}
+	}
+	return append(b, ']'), nil
+}
+
+// NDJSONWriter writes errors encoded like MarshalErrors as newline
+// delimited JSON, reusing one buffer. An NDJSONWriter is safe for concurrent use.
+//
+//	w := errific.NewNDJSONWriter(f)
+//	for _, err := range errs {
+//		w.Write(err)
+//	}
+type NDJSONWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf []byte
+}
+
+// NewNDJSONWriter returns an NDJSONWriter writing to w.
+func NewNDJSONWriter(w io.Writer) *NDJSONWriter {
+	return &NDJSONWriter{w: w}
+}
+
+// Write writes err as a line of JSON. Nil errors are ignored.
+func (w *NDJSONWriter) Write(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	b, marshalErr := appendErrorJSON(w.buf[:0], err)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	w.buf = append(b, '\n')
+	_, writeErr := w.w.Write(w.buf)
+	return writeErr
+}
+
+// WriteErrors writes errs as lines of JSON with one write. Nil errors are skipped.
+func (w *NDJSONWriter) WriteErrors(errs []error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	b := w.buf[:0]
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		var marshalErr error
+		if b, marshalErr = appendErrorJSON(b, err); marshalErr != nil {
+			return marshalErr
+		}
+		b = append(b, '\n')
+	}
+	w.buf = b
+	if len(b) == 0 {
+		return nil
+	}
+	_, err := w.w.Write(b)
+	return err
+}
+
+// appendErrorJSON appends err encoded like MarshalJSON to b.
+// Errors other than errific are encoded as the ErrorInfo of their chain.
+func appendErrorJSON(b []byte, err error) ([]byte, error) {
+	e, ok := err.(errific)
+	if ok && (c.maxJSONSize > 0 || c.compressContext > 0) {
+		line, err := e.MarshalJSON()
+		return append(b, line...), err
+	}
+
+	v := errorJSON{ErrorInfo: ResolveChain(err)}
+	if ok {
+		v.Stack = string(e.stack)
+		for _, err := range e.errs {
+			v.Wrapped = append(v.Wrapped, mask(err.Error()))
+		}
+		if c.chainStats {
+			v.Depth, v.WrapCount = Depth(e), WrapCount(e)
+		}
+	}
+	return appendJSON(b, v)
+}
+
+// appendJSON appends v encoded as by json.Marshal to b.
+func appendJSON(b []byte, v errorJSON) ([]byte, error) {
+	n := 0
+	key := func(k string) {
+		if n > 0 {
+			b = append(b, ',')
+		}
+		n++
+		b = appendJSONString(b, k)
+		b = append(b, ':')
+	}
+	str := func(k, s string) {
+		if s != "" {
+			key(k)
+			b = appendJSONString(b, s)
+		}
+	}
+	integer := func(k string, i int64) {
+		if i != 0 {
+			key(k)
+			b = strconv.AppendInt(b, i, 10)
+		}
+	}
+
+	b = append(b, '{')
+	str("message", v.Message)
+	str("caller", v.Caller)
+	str("code", v.Code)
+	str("category", string(v.Category))
+	str("correlation_id", v.CorrelationID)
+	str("request_id", v.RequestID)
+	str("tenant", v.Tenant)
+	if len(v.Labels) > 0 {
+		key("labels")
+		b = append(b, '{')
+		for i, k := range sortedKeys(v.Labels) {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, k)
+			b = append(b, ':')
+			b = appendJSONString(b, v.Labels[k])
+		}
+		b = append(b, '}')
+	}
+	if len(v.Context) > 0 {
+		key("context")
+		b = append(b, '{')
+		for i, k := range sortedKeys(v.Context) {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, k)
+			b = append(b, ':')
+			var err error
+			if b, err = appendJSONValue(b, v.Context[k]); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, '}')
+	}
+	if u := v.Upstream; u != nil {
+		key("upstream")
+		b = append(b, `{"service":`...)
+		b = appendJSONString(b, u.Service)
+		if u.Code != "" {
+			b = append(b, `,"code":`...)
+			b = appendJSONString(b, u.Code)
+		}
+		if u.Status != 0 {
+			b = append(b, `,"status":`...)
+			b = strconv.AppendInt(b, int64(u.Status), 10)
+		}
+		b = append(b, '}')
+	}
+	if s := v.Service; s != nil {
+		key("service")
+		b = append(b, `{"name":`...)
+		b = appendJSONString(b, s.Name)
+		if s.Version != "" {
+			b = append(b, `,"version":`...)
+			b = appendJSONString(b, s.Version)
+		}
+		if s.Env != "" {
+			b = append(b, `,"env":`...)
+			b = appendJSONString(b, s.Env)
+		}
+		b = append(b, '}')
+	}
+	if cl := v.Classification; cl != nil {
+		key("classification")
+		b = append(b, `{"source":`...)
+		b = appendJSONString(b, cl.Source)
+		b = append(b, `,"confidence":`...)
+		var err error
+		if b, err = appendJSONFloat(b, cl.Confidence); err != nil {
+			return nil, err
+		}
+		b = append(b, '}')
+	}
+	if d := v.Deprecation; d != nil {
+		key("deprecation")
+		b = append(b, '{')
+		if d.Replacement != "" {
+			b = append(b, `"replacement":`...)
+			b = appendJSONString(b, d.Replacement)
+			b = append(b, ',')
+		}
+		sunset, err := d.Sunset.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, `"sunset":`...)
+		b = append(b, sunset...)
+		b = append(b, '}')
+	}
+	integer("http_status", int64(v.HTTPStatus))
+	if v.Retryable {
+		key("retryable")
+		b = append(b, "true"...)
+	}
+	if v.RetryAfter != 0 {
+		key("retry_after")
+		b = appendSeconds(b, v.RetryAfter)
+	}
+	if r := v.LogRef; r != nil {
+		key("log_ref")
+		b = append(b, `{"stream":`...)
+		b = appendJSONString(b, r.Stream)
+		b = append(b, `,"offset":`...)
+		b = appendJSONString(b, r.Offset)
+		b = append(b, '}')
+	}
+	str("cancel_cause", v.CancelCause)
+	if len(v.Expectations) > 0 {
+		key("expectations")
+		b = append(b, '[')
+		for i, m := range v.Expectations {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = append(b, `{"field":`...)
+			b = appendJSONString(b, m.Field)
+			var err error
+			b = append(b, `,"expected":`...)
+			if b, err = appendJSONValue(b, m.Expected); err != nil {
+				return nil, err
+			}
+			b = append(b, `,"actual":`...)
+			if b, err = appendJSONValue(b, m.Actual); err != nil {
+				return nil, err
+			}
+			b = append(b, '}')
+		}
+		b = append(b, ']')
+	}
+	if len(v.Fields) > 0 {
+		key("fields")
+		b = append(b, '{')
+		for i, k := range sortedKeys(v.Fields) {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, k)
+			b = append(b, ':')
+			var err error
+			if b, err = appendJSONValue(b, v.Fields[k]); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, '}')
+	}
+	if len(v.Docs) > 0 {
+		key("docs")
+		b = append(b, '[')
+		for i, d := range v.Docs {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = append(b, `{"url":`...)
+			b = appendJSONString(b, d.URL)
+			if d.Title != "" {
+				b = append(b, `,"title":`...)
+				b = appendJSONString(b, d.Title)
+			}
+			if d.Snippet != "" {
+				b = append(b, `,"snippet":`...)
+				b = appendJSONString(b, d.Snippet)
+			}
+			if d.Lang != "" {
+				b = append(b, `,"lang":`...)
+				b = appendJSONString(b, d.Lang)
+			}
+			b = append(b, '}')
+		}
+		b = append(b, ']')
+	}
+	if len(v.Wrapped) > 0 {
+		key("wrapped")
+		b = append(b, '[')
+		for i, wrapped := range v.Wrapped {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, wrapped)
+		}
+		b = append(b, ']')
+	}
+	str("stack", v.Stack)
+	integer("depth", int64(v.Depth))
+	integer("wrap_count", int64(v.WrapCount))
+	return append(b, '}'), nil
+}
+
+// appendJSONValue appends the context value v encoded as by json.Marshal to b.
+func appendJSONValue(b []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, "null"...), nil
+	case string:
+		return appendJSONString(b, v), nil
+	case bool:
+		return strconv.AppendBool(b, v), nil
+	case int:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(b, v, 10), nil
+	case int32:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case uint:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(b, v, 10), nil
+	case uint32:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case time.Duration:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case float64:
+		if encoded, err := appendJSONFloat(b, v); err == nil {
+			return encoded, nil
+		}
+		return appendJSONString(b, unserializable(v)), nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return appendJSONString(b, unserializable(v)), nil
+		}
+		return append(b, encoded...), nil
+	}
+}
+
+// appendJSONFloat appends f formatted as by json.Marshal to b.
+func appendJSONFloat(b []byte, f float64) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("json: unsupported value: %s", strconv.FormatFloat(f, 'g', -1, 64))
+	}
+
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b = strconv.AppendFloat(b, f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9.
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b, nil
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends s quoted and escaped as by json.Marshal to b,
+// escaping HTML characters and replacing invalid UTF-8 with U+FFFD.
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = utf8.AppendRune(b, utf8.RuneError)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
@@ -0,0 +1,110 @@
+package errific_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+// TestMarshalErrors asserts errors encoded by MarshalErrors and NDJSONWriter
+// are byte for byte the json.Marshal of each error, for every metadata field.
+func TestMarshalErrors(t *testing.T) {
+	Configure(WithStack, ChainStats, ServiceIdentity("billing", "v1.4.2", "production"))
+	defer Configure()
+	var ErrQuery Err = "error querying thing %s"
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(errors.New("client went away"))
+
+	// errors are created in a closure for stacks of frames outside GOROOT.
+	errs := func() []error {
+		return []error{
+			ErrQuery.New(),
+			ErrQuery.Errorf("abc").
+				WithCode("QUERY_001").
+				WithCategory(CategoryServer).
+				WithCorrelationID("corr-\"1\"").
+				WithRequestID("req-1").
+				WithTenant("acme").
+				WithLabel("region", "us-east-1").
+				WithLabels(map[string]string{"zone": "a", "<tag>": "&"}).
+				WithContext(map[string]any{
+					"attempt": 3,
+					"ratio":   0.25,
+					"big":     1e21,
+					"ok":      true,
+					"nil":     nil,
+					"text":    "line\nbreak\u2028",
+					"slice":   []int{1, 2},
+					"chan":    make(chan int),
+				}).
+				WithUpstream("inventory", "INV_404", 404).
+				WithClassification("rules", 0.9).
+				WithDeprecated("QUERY_002", time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)).
+				WithHTTPStatus(503).
+				WithRetryable(true).
+				WithRetryAfter(1500*time.Millisecond).
+				WithLogRef("app", "42").
+				WithCancelCause(ctx).
+				WithDoc(Doc{URL: "https://example.com/query", Title: "Query", Lang: "en"}).
+				WithFields(Priority.Value(2), OwnerField.Value(Owner{Team: "payments"}), Internal.Value("shard-7")),
+			ErrQuery.New(io.EOF, ErrQuery.New().WithCode("INNER_001")).WithCode("OUTER_001"),
+			Expectation(ErrQuery, "status", "active", "deleted"),
+			errors.New("connection reset"),
+			Compose("composed", ComposeCaller("users/handler.go:42.Handle"), ComposeWrapped(io.ErrUnexpectedEOF)),
+		}
+	}()
+
+	var want [][]byte
+	for _, err := range errs {
+		// errors other than errific are encoded as the ErrorInfo of their chain.
+		var v any = ResolveChain(err)
+		if _, ok := err.(json.Marshaler); ok {
+			v = err
+		}
+		b, marshalErr := json.Marshal(v)
+		if marshalErr != nil {
+			t.Fatal(marshalErr)
+		}
+		want = append(want, b)
+	}
+
+	b, err := MarshalErrors(errs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []json.RawMessage
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("MarshalErrors encoded %d errors, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !bytes.Equal(got[i], want[i]) {
+			t.Errorf("MarshalErrors error %d:\n got %s\nwant %s", i, got[i], want[i])
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := NewNDJSONWriter(&buf).WriteErrors(errs); err != nil {
+		t.Fatal(err)
+	}
+	scanner := bufio.NewScanner(&buf)
+	scanner.Buffer(nil, 1<<20)
+	for i := 0; scanner.Scan(); i++ {
+		if i >= len(want) {
+			t.Fatalf("NDJSONWriter wrote more than %d errors", len(want))
+		}
+		if !bytes.Equal(scanner.Bytes(), want[i]) {
+			t.Errorf("NDJSONWriter error %d:\n got %s\nwant %s", i, scanner.Bytes(), want[i])
+		}
+	}
+}
@@ -0,0 +1,320 @@
+package errific_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+var budget = flag.Bool("budget", false, "assert benchmarks against testdata/budget.txt")
+
+var ErrBench Err = "bench error"
+
+var benchmarks = map[string]func(b *testing.B){
+	"BenchmarkNew":              BenchmarkNew,
+	"BenchmarkNewWrap":          BenchmarkNewWrap,
+	"BenchmarkWithChain":        BenchmarkWithChain,
+	"BenchmarkErrorDeep":        BenchmarkErrorDeep,
+	"BenchmarkResolveChain":     BenchmarkResolveChain,
+	"BenchmarkMarshalInfo":      BenchmarkMarshalInfo,
+	"BenchmarkMarshalJSON":      BenchmarkMarshalJSON,
+	"BenchmarkNewWithStack":     BenchmarkNewWithStack,
+	"BenchmarkEncodeHeader":     BenchmarkEncodeHeader,
+	"BenchmarkGetCodeDeep":      BenchmarkGetCodeDeep,
+	"BenchmarkIsRetryableDeep":  BenchmarkIsRetryableDeep,
+	"BenchmarkInheritDeep":      BenchmarkInheritDeep,
+	"BenchmarkWrapfFormat":      BenchmarkWrapfFormat,
+	"BenchmarkRegistryVerify":   BenchmarkRegistryVerify,
+	"BenchmarkJournalRecord":    BenchmarkJournalRecord,
+	"BenchmarkAggregatorRecord": BenchmarkAggregatorRecord,
+	"BenchmarkMarshalErrors":    BenchmarkMarshalErrors,
+	"BenchmarkResponseCacheHit": BenchmarkResponseCacheHit,
+}
+
+func BenchmarkNew(b *testing.B) {
+	Configure()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ErrBench.New()
+	}
+}
+
+func BenchmarkNewWrap(b *testing.B) {
+	Configure()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ErrBench.New(io.EOF)
+	}
+}
+
+func BenchmarkWithChain(b *testing.B) {
+	Configure()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ErrBench.New(io.EOF).
+			WithCode("BENCH_001").
+			WithCategory(CategoryServer).
+			WithCorrelationID("abc-123").
+			WithRequestID("req-123").
+			WithTenant("acme").
+			WithLabel("region", "us-east-1").
+			WithLabels(map[string]string{"zone": "a"}).
+			WithHTTPStatus(500).
+			WithRetryable(true).
+			WithRetryAfter(time.Second).
+			WithCode("BENCH_002").
+			WithCategory(CategoryNetwork).
+			WithCorrelationID("def-456").
+			WithRequestID("req-456").
+			WithTenant("globex")
+	}
+}
+
+func deepChain(depth int) error {
+	err := error(io.EOF)
+	for i := 0; i < depth; i++ {
+		err = ErrBench.New(err).WithCode("BENCH_" + strconv.Itoa(i))
+	}
+	return err
+}
+
+func BenchmarkErrorDeep(b *testing.B) {
+	Configure()
+	err := deepChain(10)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = err.Error()
+	}
+}
+
+func BenchmarkResolveChain(b *testing.B) {
+	Configure()
+	err := deepChain(10)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = ResolveChain(err)
+	}
+}
+
+func BenchmarkMarshalInfo(b *testing.B) {
+	Configure()
+	err := deepChain(10)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(ResolveChain(err)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	Configure()
+	err := ErrBench.New(deepChain(10)).
+		WithCode("BENCH_001").
+		WithCategory(CategoryServer).
+		WithCorrelationID("abc-123").
+		WithLabel("region", "us-east-1").
+		WithContext(map[string]any{"attempt": 1}).
+		WithRetryAfter(time.Second)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(err); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewWithStack(b *testing.B) {
+	Configure(WithStack)
+	defer Configure()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ErrBench.New()
+	}
+}
+
+func BenchmarkEncodeHeader(b *testing.B) {
+	Configure()
+	err := ErrBench.New().
+		WithCode("BENCH_001").
+		WithCategory(CategoryServer).
+		WithCorrelationID("abc-123").
+		WithRetryAfter(time.Second)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = EncodeHeader(err)
+	}
+}
+
+func BenchmarkGetCodeDeep(b *testing.B) {
+	Configure(Resolve(Innermost, FieldCode))
+	defer Configure()
+	err := deepChain(10)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = GetCode(err)
+	}
+}
+
+func BenchmarkIsRetryableDeep(b *testing.B) {
+	Configure()
+	err := deepChain(10)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = IsRetryable(err)
+	}
+}
+
+func BenchmarkInheritDeep(b *testing.B) {
+	Configure(InheritMetadata)
+	defer Configure()
+	err := deepChain(10)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = ErrBench.New(err)
+	}
+}
+
+func BenchmarkWrapfFormat(b *testing.B) {
+	Configure()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ErrBench.Wrapf("cause: %w", io.EOF)
+	}
+}
+
+func BenchmarkRegistryVerify(b *testing.B) {
+	registry := NewRegistry()
+	observed := make([]string, 100)
+	for i := range observed {
+		observed[i] = "BENCH_" + strconv.Itoa(i)
+		registry.Register(observed[i], ErrBench)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = registry.Verify(observed)
+	}
+}
+
+// TestBudget runs each benchmark and fails when ns/op or allocs/op exceed
+// testdata/budget.txt, which is in benchstat format. Budgets are measured
+// ns/op doubled for machine noise, and exact allocs/op.
+//
+//	go test -run TestBudget -budget
+func TestBudget(t *testing.T) {
+	if !*budget {
+		t.Skip("run with -budget to assert the performance budget")
+	}
+
+	f, err := os.Open("testdata/budget.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+
+		name := strings.SplitN(fields[0], "-", 2)[0]
+		bench, ok := benchmarks[name]
+		if !ok {
+			t.Errorf("%s: no such benchmark", name)
+			continue
+		}
+
+		maxNs, _ := strconv.ParseFloat(fields[2], 64)
+		maxAllocs, _ := strconv.ParseInt(fields[4], 10, 64)
+
+		r := testing.Benchmark(bench)
+		if ns := float64(r.NsPerOp()); ns > maxNs {
+			t.Errorf("%s: %.0f ns/op exceeds budget of %.0f ns/op", name, ns, maxNs)
+		}
+		if allocs := r.AllocsPerOp(); allocs > maxAllocs {
+			t.Errorf("%s: %d allocs/op exceeds budget of %d allocs/op", name, allocs, maxAllocs)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkJournalRecord(b *testing.B) {
+	Configure()
+	journal := NewJournal(1024)
+	var err error = ErrBench.New(io.EOF).WithCode("BENCH_001").WithCategory(CategoryServer)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		journal.Record(err)
+	}
+}
+
+func BenchmarkAggregatorRecord(b *testing.B) {
+	Configure()
+	aggregator := NewAggregator(256)
+	var err error = ErrBench.New(io.EOF).WithCode("BENCH_001").WithCategory(CategoryServer)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		aggregator.Record(err)
+	}
+}
+
+func BenchmarkMarshalErrors(b *testing.B) {
+	Configure()
+	errs := make([]error, 100)
+	for i := range errs {
+		errs[i] = ErrBench.New(io.EOF).
+			WithCode("BENCH_001").
+			WithCategory(CategoryServer).
+			WithLabel("region", "us-east-1").
+			WithContext(map[string]any{"attempt": i})
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := MarshalErrors(errs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkResponseCacheHit(b *testing.B) {
+	Configure()
+	responses := NewResponseCache(256, time.Hour, nil)
+	var err error = ErrBench.New(io.EOF).
+		WithCode("BENCH_001").
+		WithCategory(CategoryServer).
+		WithTenant("acme").
+		WithContext(map[string]any{"attempt": 1})
+	if _, err := responses.Body(err); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = responses.Body(err)
+	}
+}
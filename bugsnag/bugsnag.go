@@ -0,0 +1,205 @@
+// Package bugsnag formats errific errors as Bugsnag Error Reporting API events.
+package bugsnag
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/leefernandes/errific"
+)
+
+// PayloadVersion of the Bugsnag Error Reporting API.
+const PayloadVersion = "5"
+
+// Metadata tabs of events.
+const (
+	TabErrific = "errific"
+	TabLabels  = "labels"
+	TabContext = "context"
+	TabRetry   = "retry"
+)
+
+// Payload is a Bugsnag Error Reporting API payload.
+type Payload struct {
+	APIKey         string   `json:"apiKey"`
+	PayloadVersion string   `json:"payloadVersion"`
+	Notifier       Notifier `json:"notifier"`
+	Events         []Event  `json:"events"`
+}
+
+// Notifier identifies the notifier of a Payload.
+type Notifier struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
+
+// Event is a Bugsnag event of an error.
+type Event struct {
+	Exceptions     []Exception               `json:"exceptions"`
+	Severity       string                    `json:"severity"`
+	SeverityReason SeverityReason            `json:"severityReason"`
+	Unhandled      bool                      `json:"unhandled"`
+	Context        string                    `json:"context,omitempty"`
+	GroupingHash   string                    `json:"groupingHash,omitempty"`
+	App            App                       `json:"app"`
+	Device         Device                    `json:"device"`
+	MetaData       map[string]map[string]any `json:"metaData,omitempty"`
+}
+
+// Exception is the class, message, and stacktrace of an error.
+type Exception struct {
+	ErrorClass string       `json:"errorClass"`
+	Message    string       `json:"message"`
+	Stacktrace []StackFrame `json:"stacktrace"`
+	Type       string       `json:"type"`
+}
+
+// StackFrame is a frame of an Exception stacktrace.
+type StackFrame struct {
+	File       string `json:"file"`
+	LineNumber int    `json:"lineNumber,omitempty"`
+	Method     string `json:"method,omitempty"`
+	InProject  bool   `json:"inProject"`
+}
+
+// SeverityReason is why an Event has its severity.
+type SeverityReason struct {
+	Type string `json:"type"`
+}
+
+// App is the app an error occurred in.
+type App struct {
+	ReleaseStage string `json:"releaseStage,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// Device is the device an error occurred on.
+type Device struct {
+	Hostname string `json:"hostname,omitempty"`
+}
+
+// NewPayload returns the Payload of the Events of errs for apiKey.
+//
+//	body, _ := json.Marshal(bugsnag.NewPayload(apiKey, bugsnag.NewEvent(err)))
+//	http.Post("https://notify.bugsnag.com", "application/json", bytes.NewReader(body))
+func NewPayload(apiKey string, events ...Event) Payload {
+	return Payload{
+		APIKey:         apiKey,
+		PayloadVersion: PayloadVersion,
+		Notifier: Notifier{
+			Name:    "errific",
+			Version: "1",
+			URL:     "https://github.com/leefernandes/errific",
+		},
+		Events: events,
+	}
+}
+
+// NewEvent returns the handled Event of err.
+//
+// The error class is the code of err, or its category, and the grouping
+// hash its code. Severity is mapped from errific.LogLevel, the app from the
+// errific.ServiceIdentity, and the stacktrace from errific.Frames, with
+// frames of dependencies in the module cache not in project.
+// Labels, context, and retry information are metadata tabs, and the
+// remaining metadata the errific tab.
+//
+//	event := bugsnag.NewEvent(err)
+func NewEvent(err error) Event {
+	info := errific.ResolveChain(err)
+	e := Event{
+		Exceptions: []Exception{{
+			ErrorClass: info.Code,
+			Message:    err.Error(),
+			Stacktrace: []StackFrame{},
+			Type:       "go",
+		}},
+		Severity:       Severity(errific.LogLevel(err)),
+		SeverityReason: SeverityReason{Type: "handledError"},
+		GroupingHash:   info.Code,
+	}
+
+	exception := &e.Exceptions[0]
+	if exception.ErrorClass == "" {
+		exception.ErrorClass = string(info.Category)
+	}
+	if exception.ErrorClass == "" {
+		exception.ErrorClass = "error"
+	}
+
+	for _, frame := range errific.Frames(err) {
+		exception.Stacktrace = append(exception.Stacktrace, StackFrame{
+			File:       frame.File,
+			LineNumber: frame.Line,
+			Method:     frame.Function,
+			InProject:  inProject(frame.File),
+		})
+	}
+	if len(exception.Stacktrace) > 0 {
+		e.Context = exception.Stacktrace[0].Method
+	}
+
+	if s := info.Service; s != nil {
+		e.App = App{ReleaseStage: s.Env, Version: s.Version}
+	}
+	e.Device.Hostname, _ = os.Hostname()
+
+	tabs := map[string]map[string]any{}
+	tab := func(name, key string, value any) {
+		if tabs[name] == nil {
+			tabs[name] = map[string]any{}
+		}
+		tabs[name][key] = value
+	}
+	for k, v := range info.Labels {
+		tab(TabLabels, k, v)
+	}
+	for k, v := range info.Context {
+		tab(TabContext, k, v)
+	}
+	if info.Retryable {
+		tab(TabRetry, "retryable", true)
+	}
+	if info.RetryAfter > 0 {
+		tab(TabRetry, "retry_after", info.RetryAfter.String())
+	}
+	for k, v := range map[string]string{
+		"code":           info.Code,
+		"category":       string(info.Category),
+		"correlation_id": info.CorrelationID,
+		"request_id":     info.RequestID,
+		"tenant":         info.Tenant,
+		"caller":         info.Caller,
+	} {
+		if v != "" {
+			tab(TabErrific, k, v)
+		}
+	}
+	if info.HTTPStatus != 0 {
+		tab(TabErrific, "http_status", info.HTTPStatus)
+	}
+	if len(tabs) > 0 {
+		e.MetaData = tabs
+	}
+
+	return e
+}
+
+// Severity returns the Bugsnag severity of level: error, warning, or info.
+func Severity(level slog.Level) string {
+	switch {
+	case level >= slog.LevelError:
+		return "error"
+	case level >= slog.LevelWarn:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// inProject reports whether file is not of a dependency in the module cache.
+func inProject(file string) bool {
+	return !strings.Contains(file, "/pkg/mod/")
+}
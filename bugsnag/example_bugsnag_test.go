@@ -0,0 +1,37 @@
+package bugsnag_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/bugsnag"
+)
+
+func ExampleNewEvent() {
+	errific.Configure(errific.WithStack, errific.ServiceIdentity("things", "v1.2.3", "prod"))
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryNetwork).
+		WithLabel("region", "us-east-1").
+		WithRetryAfter(time.Second).
+		WithRetryable(true)
+
+	e := bugsnag.NewEvent(err)
+	exception := e.Exceptions[0]
+	fmt.Println(e.Severity, e.GroupingHash, e.App.ReleaseStage, e.App.Version, e.Context)
+	fmt.Println(exception.ErrorClass, exception.Stacktrace[0].Method, exception.Stacktrace[0].InProject)
+	fmt.Println(e.MetaData[bugsnag.TabLabels], e.MetaData[bugsnag.TabRetry])
+
+	payload := bugsnag.NewPayload("api-key", e)
+	fmt.Println(payload.PayloadVersion, len(payload.Events))
+
+	// Output:
+	// error QUERY_001 prod v1.2.3 ExampleNewEvent
+	// QUERY_001 ExampleNewEvent true
+	// map[region:us-east-1] map[retry_after:1s retryable:true]
+	// 5 1
+}
@@ -0,0 +1,136 @@
+package errific
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrBulkheadFull is the Err of errors returned by Bulkhead when rejecting calls.
+var ErrBulkheadFull Err = "bulkhead full"
+
+// Context keys set by Bulkhead rejections.
+const (
+	ContextBulkheadLimit      = "bulkhead_limit"
+	ContextBulkheadActive     = "bulkhead_active"
+	ContextBulkheadQueued     = "bulkhead_queued"
+	ContextBulkheadQueueLimit = "bulkhead_queue_limit"
+)
+
+// Bulkhead limits the number of concurrent calls, queueing a bounded
+// number of callers for a free slot and rejecting the rest.
+// A Bulkhead is safe for concurrent use.
+//
+//	var bulkhead = errific.NewBulkhead(10, 100)
+//
+//	err := bulkhead.Do(ctx, func(ctx context.Context) error {
+//		return client.Fetch(ctx, id)
+//	})
+type Bulkhead struct {
+	slots chan struct{}
+	queue int
+
+	mu      sync.Mutex
+	queued  int
+	average time.Duration // moving average of call durations.
+}
+
+// NewBulkhead returns a Bulkhead running limit calls at once
+// with up to queue callers waiting for a slot.
+func NewBulkhead(limit, queue int) *Bulkhead {
+	if limit < 1 {
+		limit = 1
+	}
+	if queue < 0 {
+		queue = 0
+	}
+	return &Bulkhead{slots: make(chan struct{}, limit), queue: queue}
+}
+
+// Do runs fn once a slot is free. When the queue is full, or ctx is done
+// while queued, Do returns an ErrBulkheadFull error with CategoryServer,
+// HTTP status 503, a retry delay estimated from the queue depth and
+// average call duration, and the occupancy in its context.
+func (b *Bulkhead) Do(ctx context.Context, fn func(context.Context) error) error {
+	select {
+	case b.slots <- struct{}{}:
+
+	default:
+		b.mu.Lock()
+		if b.queued >= b.queue {
+			b.mu.Unlock()
+			return b.reject(nil)
+		}
+		b.queued++
+		b.mu.Unlock()
+
+		select {
+		case b.slots <- struct{}{}:
+			b.mu.Lock()
+			b.queued--
+			b.mu.Unlock()
+
+		case <-ctx.Done():
+			b.mu.Lock()
+			b.queued--
+			b.mu.Unlock()
+			return b.reject(ctx.Err())
+		}
+	}
+
+	start := time.Now()
+	defer func() {
+		<-b.slots
+		b.observe(time.Since(start))
+	}()
+
+	return fn(ctx)
+}
+
+func (b *Bulkhead) observe(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.average == 0 {
+		b.average = d
+		return
+	}
+	b.average += (d - b.average) / 8
+}
+
+func (b *Bulkhead) reject(err error) error {
+	b.mu.Lock()
+	queued, average := b.queued, b.average
+	b.mu.Unlock()
+
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	limit := cap(b.slots)
+	e := ErrBulkheadFull.newAt(2, errs...).
+		WithCategory(CategoryServer).
+		WithHTTPStatus(http.StatusServiceUnavailable).
+		WithRetryable(true).
+		WithContext(map[string]any{
+			ContextBulkheadLimit:      limit,
+			ContextBulkheadActive:     len(b.slots),
+			ContextBulkheadQueued:     queued,
+			ContextBulkheadQueueLimit: b.queue,
+		})
+
+	// each slot drains the queue ahead of a retry at the average call duration.
+	if d := average * time.Duration(queued+1) / time.Duration(limit); d > 0 {
+		e = e.WithRetryAfter(d)
+	}
+
+	return e
+}
+
+// Occupancy returns the number of running and queued calls.
+func (b *Bulkhead) Occupancy() (active, queued int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.slots), b.queued
+}
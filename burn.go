@@ -0,0 +1,289 @@
+package errific
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrBudgetBurn is reported by BurnAlerter when an error budget burns
+// faster than a BurnWindow threshold.
+var ErrBudgetBurn Err = "error budget burning"
+
+// CodeBudgetBurn is the code of ErrBudgetBurn alert errors.
+const CodeBudgetBurn = "ERROR_BUDGET_BURN"
+
+// Context keys set by BurnAlerter alert errors.
+const (
+	ContextBurnSLO       = "burn_slo"
+	ContextBurnWindow    = "burn_window"
+	ContextBurnRate      = "burn_rate"
+	ContextBurnThreshold = "burn_threshold"
+	ContextBurnErrors    = "burn_errors"
+	ContextBurnRequests  = "burn_requests"
+	ContextBurnTopCode   = "burn_top_code"
+)
+
+// BurnWindow alerts when the error budget burn rate over Window
+// reaches Threshold. A burn rate of 1 spends the budget exactly
+// over the SLO period.
+type BurnWindow struct {
+	// Name identifies the window in alert errors, such as "fast".
+	Name      string
+	Window    time.Duration
+	Threshold float64
+}
+
+// DefaultBurnWindows are a fast window paging on 2% of a 30 day budget
+// spent in an hour, and a slow window on 5% spent in six hours.
+var DefaultBurnWindows = []BurnWindow{
+	{Name: "fast", Window: time.Hour, Threshold: 14.4},
+	{Name: "slow", Window: 6 * time.Hour, Threshold: 6},
+}
+
+// SLO is an availability objective of the requests counted by a BurnAlerter.
+type SLO struct {
+	// Name identifies the SLO in alert errors.
+	Name string
+	// Objective is the fraction of requests that succeed, such as 0.999.
+	Objective float64
+	// Code of the counted errors. Empty counts all errors.
+	Code string
+	// Windows alerted on. Default is DefaultBurnWindows.
+	Windows []BurnWindow
+	// Docs, such as runbooks, are added to alert errors.
+	Docs []Doc
+}
+
+// BurnAlerter evaluates SLOs against the errors recorded in a Journal and
+// the requests counted by Request, reporting an ErrBudgetBurn alert error
+// when a burn rate crosses a window threshold.
+// Errors older than the Journal keeps are not counted, so size the Journal
+// for the errors of the longest window.
+// A BurnAlerter is safe for concurrent use.
+//
+//	alerter := errific.NewBurnAlerter(journal,
+//		errific.WebhookReporter(slackURL, errific.SlackMessage, nil),
+//		errific.SLO{
+//			Name:      "checkout",
+//			Objective: 0.999,
+//			Docs:      []errific.Doc{{URL: "https://runbooks.example.com/checkout"}},
+//		})
+//
+//	go alerter.Run(ctx, time.Minute)
+type BurnAlerter struct {
+	journal  *Journal
+	reporter Reporter
+	slos     []SLO
+
+	mu       sync.Mutex
+	buckets  []uint64
+	minutes  []int64
+	alerting [][]bool
+}
+
+// NewBurnAlerter returns a BurnAlerter of slos evaluated against journal.
+// Alert errors are passed to reporter, or to Report if reporter is nil.
+func NewBurnAlerter(journal *Journal, reporter Reporter, slos ...SLO) *BurnAlerter {
+	slos = slices.Clone(slos)
+	longest := time.Duration(0)
+	alerting := make([][]bool, len(slos))
+	for i := range slos {
+		if len(slos[i].Windows) == 0 {
+			slos[i].Windows = DefaultBurnWindows
+		}
+		for _, w := range slos[i].Windows {
+			longest = max(longest, w.Window)
+		}
+		alerting[i] = make([]bool, len(slos[i].Windows))
+	}
+
+	size := int(longest/time.Minute) + 1
+	return &BurnAlerter{
+		journal:  journal,
+		reporter: reporter,
+		slos:     slos,
+		buckets:  make([]uint64, size),
+		minutes:  make([]int64, size),
+		alerting: alerting,
+	}
+}
+
+// Request counts a request, failed or not, toward the burn rates.
+func (b *BurnAlerter) Request() {
+	minute := time.Now().Unix() / 60
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	i := int(minute % int64(len(b.buckets)))
+	if b.minutes[i] != minute {
+		b.minutes[i] = minute
+		b.buckets[i] = 0
+	}
+	b.buckets[i]++
+}
+
+// requests returns the number of requests counted since.
+func (b *BurnAlerter) requests(since time.Time) uint64 {
+	from := since.Unix() / 60
+	var n uint64
+	for i, minute := range b.minutes {
+		if minute >= from {
+			n += b.buckets[i]
+		}
+	}
+	return n
+}
+
+// Evaluate computes the burn rate of each SLO window, reporting an alert
+// error when a rate reaches its threshold. A window alerts again only after
+// its rate falls below the threshold. Evaluate returns the alert errors.
+// Alerts are reported without holding the BurnAlerter lock.
+func (b *BurnAlerter) Evaluate() []error {
+	alerts := b.burn()
+
+	for _, err := range alerts {
+		if b.reporter != nil {
+			b.reporter.Report(err)
+		} else {
+			Report(err)
+		}
+	}
+
+	return alerts
+}
+
+// burn updates the alerting state of each SLO window, returning the
+// alert errors of windows that started alerting.
+func (b *BurnAlerter) burn() (alerts []error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	records := b.journal.Records()
+
+	for i, slo := range b.slos {
+		budget := 1 - slo.Objective
+
+		for j, w := range slo.Windows {
+			since := now.Add(-w.Window)
+			codes := NewAggregator(8)
+			errs := 0
+			for _, r := range records {
+				if r.Time.Before(since) {
+					continue
+				}
+				if slo.Code == "" || r.Code == slo.Code {
+					errs++
+					codes.Add(r)
+				}
+			}
+
+			rate := 0.0
+			requests := b.requests(since)
+			if requests > 0 && budget > 0 {
+				rate = float64(errs) / float64(requests) / budget
+			}
+
+			burning := rate >= w.Threshold
+			if burning == b.alerting[i][j] {
+				continue
+			}
+			b.alerting[i][j] = burning
+			if !burning {
+				continue
+			}
+
+			fields := map[string]any{
+				ContextBurnSLO:       slo.Name,
+				ContextBurnWindow:    w.Name,
+				ContextBurnRate:      rate,
+				ContextBurnThreshold: w.Threshold,
+				ContextBurnErrors:    errs,
+				ContextBurnRequests:  requests,
+			}
+			if counts := codes.Counts(); len(counts) > 0 {
+				fields[ContextBurnTopCode] = counts[0].Code
+			}
+
+			err := ErrBudgetBurn.New().
+				WithCode(CodeBudgetBurn).
+				WithCategory(CategoryServer).
+				WithContext(fields).
+				WithDoc(slo.Docs...)
+
+			alerts = append(alerts, err)
+		}
+	}
+
+	return alerts
+}
+
+// Run calls Evaluate every interval until ctx is done.
+func (b *BurnAlerter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.Evaluate()
+		}
+	}
+}
+
+// WebhookReporter returns a Reporter posting the JSON body built of each
+// error to url with client, or http.DefaultClient if nil.
+// Reporters return no errors, so errors building or posting a body are
+// dropped; use a client Transport to observe them.
+//
+//	errific.WebhookReporter(slackURL, errific.SlackMessage, nil)
+func WebhookReporter(url string, build func(error) ([]byte, error), client *http.Client) Reporter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return ReporterFunc(func(err error) {
+		body, err := build(err)
+		if err != nil {
+			return
+		}
+		resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return
+		}
+		resp.Body.Close()
+	})
+}
+
+// SlackMessage builds a Slack incoming webhook message of err,
+// with its code and docs.
+func SlackMessage(err error) ([]byte, error) {
+	var b bytes.Buffer
+	if code := GetCode(err); code != "" {
+		fmt.Fprintf(&b, "*[%s]* ", code)
+	}
+	b.WriteString(err.Error())
+	for _, doc := range GetDocs(err) {
+		title := doc.Title
+		if title == "" {
+			title = doc.URL
+		}
+		fmt.Fprintf(&b, "\n<%s|%s>", doc.URL, title)
+	}
+
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(map[string]string{"text": b.String()}); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(body.Bytes(), []byte("\n")), nil
+}
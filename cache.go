@@ -0,0 +1,123 @@
+package errific
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// ResponseCache memoizes rendered response bodies of identical errors
+// for a TTL, cutting serialization cost during error storms. Errors are
+// identical when they have the same message, code, category, HTTP status,
+// retry delay, tenant, and request and correlation IDs in the configured
+// HTTPView, the fields of WriteHTTP responses, so errors of different
+// tenants or requests never share a body. Renders of other metadata, such
+// as context, get the body of the first of the identical errors.
+// A ResponseCache is safe for concurrent use.
+//
+//	var responses = errific.NewResponseCache(256, time.Second, nil)
+//
+//	body, err := responses.Body(err)
+type ResponseCache struct {
+	size   int
+	ttl    time.Duration
+	render func(error) ([]byte, error)
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+// cacheKey is the resolved fields of an error its response bodies depend on.
+type cacheKey struct {
+	view          view
+	message       string
+	code          string
+	category      Category
+	httpStatus    int
+	retryable     bool
+	retryAfter    Duration
+	tenant        string
+	requestID     string
+	correlationID string
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// NewResponseCache returns a ResponseCache keeping up to size bodies
+// rendered by render for ttl. A nil render encodes errors as JSON.
+func NewResponseCache(size int, ttl time.Duration, render func(error) ([]byte, error)) *ResponseCache {
+	if size < 1 {
+		size = 1
+	}
+	if render == nil {
+		render = func(err error) ([]byte, error) {
+			return json.Marshal(err)
+		}
+	}
+	return &ResponseCache{
+		size:    size,
+		ttl:     ttl,
+		render:  render,
+		entries: make(map[cacheKey]cacheEntry, size),
+	}
+}
+
+// Body returns the cached body for errors identical to err,
+// rendering and caching it when missing or expired.
+// Bodies are not cached when the cache is full of unexpired bodies,
+// or for nil errors.
+func (rc *ResponseCache) Body(err error) ([]byte, error) {
+	if err == nil {
+		return rc.render(err)
+	}
+	key := keyOf(err)
+	now := time.Now()
+
+	rc.mu.Lock()
+	entry, ok := rc.entries[key]
+	rc.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.body, nil
+	}
+
+	body, rerr := rc.render(err)
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.entries[key]; !ok && len(rc.entries) >= rc.size {
+		for k, entry := range rc.entries {
+			if !now.Before(entry.expires) {
+				delete(rc.entries, k)
+			}
+		}
+	}
+	if _, ok := rc.entries[key]; ok || len(rc.entries) < rc.size {
+		rc.entries[key] = cacheEntry{body: body, expires: now.Add(rc.ttl)}
+	}
+
+	return body, nil
+}
+
+// keyOf returns the cacheKey of err in the configured HTTPView.
+func keyOf(err error) cacheKey {
+	info := ResolveChain(err)
+	return cacheKey{
+		view:          c.httpView,
+		message:       info.Message,
+		code:          info.Code,
+		category:      info.Category,
+		httpStatus:    info.HTTPStatus,
+		retryable:     info.Retryable,
+		retryAfter:    info.RetryAfter,
+		tenant:        info.Tenant,
+		requestID:     info.RequestID,
+		correlationID: info.CorrelationID,
+	}
+}
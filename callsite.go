@@ -0,0 +1,61 @@
+package errific
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// CallSite is the number of errors created at a caller.
+type CallSite struct {
+	Caller string `json:"caller"`
+	Count  uint64 `json:"count"`
+}
+
+// callSites counts created errors by caller when CountCallSites is configured.
+var callSites sync.Map // map[string]*atomic.Uint64
+
+func countCallSite(caller string) {
+	n, ok := callSites.Load(caller)
+	if !ok {
+		n, _ = callSites.LoadOrStore(caller, new(atomic.Uint64))
+	}
+	n.(*atomic.Uint64).Add(1)
+}
+
+// TopCallSites returns the n callers that created the most errors since
+// CountCallSites was configured, most first, so teams can find the code
+// generating the most errors without log queries. n < 1 returns all.
+//
+//	errific.Configure(errific.CountCallSites)
+//
+//	for _, site := range errific.TopCallSites(10) {
+//		fmt.Println(site.Caller, site.Count)
+//	}
+func TopCallSites(n int) []CallSite {
+	var sites []CallSite
+	callSites.Range(func(caller, count any) bool {
+		sites = append(sites, CallSite{Caller: caller.(string), Count: count.(*atomic.Uint64).Load()})
+		return true
+	})
+
+	sort.Slice(sites, func(i, j int) bool {
+		if sites[i].Count != sites[j].Count {
+			return sites[i].Count > sites[j].Count
+		}
+		return sites[i].Caller < sites[j].Caller
+	})
+
+	if n > 0 && len(sites) > n {
+		sites = sites[:n]
+	}
+	return sites
+}
+
+// ResetCallSites clears the counts of TopCallSites.
+func ResetCallSites() {
+	callSites.Range(func(caller, _ any) bool {
+		callSites.Delete(caller)
+		return true
+	})
+}
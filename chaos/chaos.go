@@ -0,0 +1,178 @@
+// Package chaos injects errific errors for resilience testing.
+//
+// Injection is disabled unless enabled by the ERRIFIC_CHAOS environment
+// variable, the -errific.chaos flag, or setting Enabled.
+//
+//	ERRIFIC_CHAOS=true ERRIFIC_CHAOS_RATE=0.05 go run ./cmd/server
+package chaos
+
+import (
+	"flag"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// Environment variables read at init.
+const (
+	EnvEnabled = "ERRIFIC_CHAOS"
+	EnvRate    = "ERRIFIC_CHAOS_RATE"
+)
+
+var (
+	// Enabled toggles injection. Default is the value of ERRIFIC_CHAOS.
+	Enabled, _ = strconv.ParseBool(os.Getenv(EnvEnabled))
+	// Rate is the probability Injectors of NewInjector inject a fault.
+	// Default is the value of ERRIFIC_CHAOS_RATE.
+	Rate, _ = strconv.ParseFloat(os.Getenv(EnvRate), 64)
+)
+
+// RegisterFlags registers -errific.chaos and -errific.chaos.rate
+// flags on fs, defaulting to the environment.
+//
+//	chaos.RegisterFlags(flag.CommandLine)
+//	flag.Parse()
+func RegisterFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&Enabled, "errific.chaos", Enabled, "inject errific errors")
+	fs.Float64Var(&Rate, "errific.chaos.rate", Rate, "probability of injecting an errific error")
+}
+
+// Label is set to "true" on injected errors so they can be told apart.
+const Label = "chaos"
+
+// Errors injected by DefaultFaults.
+var (
+	ErrInjectedServer  errific.Err = "chaos: injected server error"
+	ErrInjectedTimeout errific.Err = "chaos: injected timeout"
+	ErrInjectedNetwork errific.Err = "chaos: injected network error"
+	ErrInjectedLimit   errific.Err = "chaos: injected rate limit"
+)
+
+// Fault is an error an Injector may inject.
+type Fault struct {
+	Err        errific.Err
+	Code       string
+	Category   errific.Category
+	HTTPStatus int
+	Retryable  bool
+	RetryAfter time.Duration
+	// Weight of the fault relative to the other faults of an Injector.
+	// Zero is weighted as 1.
+	Weight float64
+}
+
+// Error returns the errific error of f, with the caller of Error.
+func (f Fault) Error() error {
+	return f.errorAt(1)
+}
+
+// errorAt returns the errific error of f with the caller skip frames
+// above the function calling errorAt.
+func (f Fault) errorAt(skip int) error {
+	e := f.Err.NewAt(skip+1).
+		WithCode(f.Code).
+		WithCategory(f.Category).
+		WithHTTPStatus(f.HTTPStatus).
+		WithRetryable(f.Retryable).
+		WithLabel(Label, "true")
+	if f.RetryAfter > 0 {
+		e = e.WithRetryAfter(f.RetryAfter)
+	}
+	return e
+}
+
+// DefaultFaults are injected by an Injector without Faults.
+var DefaultFaults = []Fault{
+	{Err: ErrInjectedServer, Code: "CHAOS_SERVER", Category: errific.CategoryServer, HTTPStatus: http.StatusInternalServerError, Weight: 4},
+	{Err: ErrInjectedTimeout, Code: "CHAOS_TIMEOUT", Category: errific.CategoryTimeout, HTTPStatus: http.StatusGatewayTimeout, Retryable: true, Weight: 2},
+	{Err: ErrInjectedNetwork, Code: "CHAOS_NETWORK", Category: errific.CategoryNetwork, HTTPStatus: http.StatusBadGateway, Retryable: true, Weight: 2},
+	{Err: ErrInjectedLimit, Code: "CHAOS_RATE_LIMITED", Category: errific.CategoryRateLimited, HTTPStatus: http.StatusTooManyRequests, RetryAfter: time.Second, Weight: 1},
+}
+
+// Maybe returns an err error with probability when Enabled, otherwise nil.
+// The caller of the error is the caller of Maybe.
+//
+//	if err := chaos.Maybe(ErrQueryThing, 0.01); err != nil {
+//		return err
+//	}
+func Maybe(err errific.Err, probability float64) error {
+	if !Enabled || rand.Float64() >= probability {
+		return nil
+	}
+	return err.NewAt(1).WithLabel(Label, "true")
+}
+
+// Injector injects weighted random Faults at a rate.
+//
+//	injector := &chaos.Injector{Rate: 0.05}
+//
+//	http.ListenAndServe(":8080", injector.Middleware(mux))
+type Injector struct {
+	// Rate is the probability of injecting a fault. Zero injects none.
+	Rate float64
+	// Faults to choose from by weight. Default is DefaultFaults.
+	Faults []Fault
+}
+
+// NewInjector returns an Injector of faults at the package Rate,
+// as set by ERRIFIC_CHAOS_RATE or -errific.chaos.rate.
+// Call NewInjector after parsing flags.
+//
+//	flag.Parse()
+//	injector := chaos.NewInjector()
+func NewInjector(faults ...Fault) *Injector {
+	return &Injector{Rate: Rate, Faults: faults}
+}
+
+// Inject returns the error of a weighted random fault at the rate
+// of the injector when Enabled, otherwise nil.
+// The caller of the error is the caller of Inject.
+func (i *Injector) Inject() error {
+	if !Enabled || rand.Float64() >= i.Rate {
+		return nil
+	}
+
+	faults := i.Faults
+	if len(faults) == 0 {
+		faults = DefaultFaults
+	}
+
+	var total float64
+	for _, f := range faults {
+		total += weight(f)
+	}
+
+	n := rand.Float64() * total
+	for _, f := range faults {
+		if n -= weight(f); n < 0 {
+			return f.errorAt(1)
+		}
+	}
+	return faults[len(faults)-1].errorAt(1)
+}
+
+func weight(f Fault) float64 {
+	if f.Weight <= 0 {
+		return 1
+	}
+	return f.Weight
+}
+
+// Middleware returns an http.Handler that responds to injected requests
+// with the fault written by errific.WriteHTTP instead of calling next,
+// so injected failures get the status, headers, and body of real ones.
+func (i *Injector) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := i.Inject()
+		if err == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		errific.WriteHTTP(w, err)
+	})
+}
@@ -0,0 +1,43 @@
+// Package chaosgrpc injects errific errors into gRPC servers for resilience testing.
+//
+// It is a separate module so errific does not depend on gRPC.
+package chaosgrpc
+
+import (
+	"context"
+
+	"github.com/leefernandes/errific/chaos"
+	errificgrpc "github.com/leefernandes/errific/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor returns an interceptor that responds to injected
+// calls with the status of the fault instead of calling the handler.
+//
+//	grpc.NewServer(grpc.UnaryInterceptor(chaosgrpc.UnaryServerInterceptor(injector)))
+func UnaryServerInterceptor(i *chaos.Injector) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := i.Inject(); err != nil {
+			return nil, Status(err).Err()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that responds to injected
+// streams with the status of the fault instead of calling the handler.
+func StreamServerInterceptor(i *chaos.Injector) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.Inject(); err != nil {
+			return Status(err).Err()
+		}
+		return handler(srv, ss)
+	}
+}
+
+// Status returns the gRPC status of the injected err, as converted by
+// the ToStatus function of the errific grpc module.
+func Status(err error) *status.Status {
+	return errificgrpc.ToStatus(err)
+}
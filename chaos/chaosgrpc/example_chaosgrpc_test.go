@@ -0,0 +1,44 @@
+package chaosgrpc_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/leefernandes/errific/chaos"
+	"github.com/leefernandes/errific/chaos/chaosgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func ExampleUnaryServerInterceptor() {
+	chaos.Enabled = true
+
+	injector := &chaos.Injector{Rate: 1, Faults: []chaos.Fault{chaos.DefaultFaults[1]}}
+	intercept := chaosgrpc.UnaryServerInterceptor(injector)
+	handler := func(ctx context.Context, req any) (any, error) { return "thing", nil }
+
+	_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	st := status.Convert(err)
+	fmt.Println(st.Code(), st.Message())
+
+	resp, err := chaosgrpc.UnaryServerInterceptor(&chaos.Injector{})(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	fmt.Println(resp, err)
+
+	// Output:
+	// DeadlineExceeded chaos: injected timeout
+	// thing <nil>
+}
+
+func ExampleStreamServerInterceptor() {
+	chaos.Enabled = true
+
+	injector := &chaos.Injector{Rate: 1, Faults: []chaos.Fault{chaos.DefaultFaults[3]}}
+	intercept := chaosgrpc.StreamServerInterceptor(injector)
+	handler := func(srv any, ss grpc.ServerStream) error { return nil }
+
+	st := status.Convert(intercept(nil, nil, &grpc.StreamServerInfo{}, handler))
+	fmt.Println(st.Code(), st.Message(), len(st.Details()) > 0)
+
+	// Output:
+	// ResourceExhausted chaos: injected rate limit true
+}
@@ -0,0 +1,76 @@
+package chaos_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/chaos"
+)
+
+func ExampleMaybe() {
+	var ErrQuery errific.Err = "error querying thing"
+
+	chaos.Enabled = false
+	fmt.Println(chaos.Maybe(ErrQuery, 1))
+
+	chaos.Enabled = true
+	err := chaos.Maybe(ErrQuery, 1)
+	fmt.Println(errific.GetLabels(err)[chaos.Label])
+	fmt.Println(strings.HasSuffix(errific.ResolveChain(err).Caller, ".ExampleMaybe"))
+
+	// Output:
+	// <nil>
+	// true
+	// true
+}
+
+func ExampleInjector_Inject() {
+	chaos.Enabled = true
+
+	off := &chaos.Injector{}
+	fmt.Println(off.Inject())
+
+	injector := &chaos.Injector{Rate: 1, Faults: chaos.DefaultFaults[:1]}
+	err := injector.Inject()
+	fmt.Println(errific.GetCode(err), strings.HasSuffix(errific.ResolveChain(err).Caller, ".ExampleInjector_Inject"))
+
+	// Output:
+	// <nil>
+	// CHAOS_SERVER true
+}
+
+func ExampleNewInjector() {
+	chaos.Enabled = true
+	chaos.Rate = 1
+	defer func() { chaos.Rate = 0 }()
+
+	err := chaos.NewInjector().Inject()
+	fmt.Println(errific.GetLabels(err)[chaos.Label])
+
+	// Output:
+	// true
+}
+
+func ExampleInjector_Middleware() {
+	chaos.Enabled = true
+
+	injector := &chaos.Injector{
+		Rate: 1,
+		Faults: []chaos.Fault{
+			{Err: chaos.ErrInjectedTimeout, Code: "CHAOS_TIMEOUT", Category: errific.CategoryTimeout, HTTPStatus: http.StatusGatewayTimeout},
+		},
+	}
+	handler := injector.Middleware(http.NotFoundHandler())
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/things", nil))
+	fmt.Println(w.Code, w.Header().Get("Content-Type"), w.Header().Get(errific.HeaderCode))
+	fmt.Print(w.Body.String())
+
+	// Output:
+	// 504 application/json CHAOS_TIMEOUT
+	// {"message":"Gateway Timeout","code":"CHAOS_TIMEOUT","category":"timeout"}
+}
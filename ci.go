@@ -0,0 +1,151 @@
+package errific
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// OutputCI formats Error() output for assertions in CI: one metadata
+	// item per line as a key padded to a fixed width and its value, with
+	// labels and context keys sorted and caller paths relative to the root
+	// of their module, so diffs of failing tests are minimal.
+	// Durations, numbers, and timestamps are formatted with Localize.
+	//
+	//	errific.Configure(errific.OutputCI)
+	OutputCI outputOption = "ci"
+)
+
+// ciKeyWidth is the width keys of OutputCI are padded to.
+const ciKeyWidth = 24
+
+func init() {
+	RegisterFormat(string(OutputCI), marshalCI)
+}
+
+// marshalCI encodes info as lines of keys and values, omitting unset fields.
+// Values with line breaks or other control characters are quoted.
+func marshalCI(info ErrorInfo) ([]byte, error) {
+	var b strings.Builder
+	line := func(key, value string) {
+		if value == "" {
+			return
+		}
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		if strings.IndexFunc(value, func(r rune) bool { return r < ' ' || r == 0x7f }) >= 0 {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(&b, "%-*s %s", ciKeyWidth, key+":", value)
+	}
+
+	line("message", info.Message)
+	if info.Caller != "" {
+		frame := ParseFrame(info.Caller)
+		frame.File = moduleRelative(frame.File)
+		line("caller", fmt.Sprintf("%s:%d.%s", frame.File, frame.Line, frame.Function))
+	}
+	line("code", info.Code)
+	line("category", string(info.Category))
+	line("correlation_id", info.CorrelationID)
+	line("request_id", info.RequestID)
+	line("tenant", info.Tenant)
+	if info.HTTPStatus != 0 {
+		line("http_status", strconv.Itoa(info.HTTPStatus))
+	}
+	if info.Retryable {
+		line("retryable", "true")
+	}
+	if info.RetryAfter > 0 {
+		line("retry_after", localize(time.Duration(info.RetryAfter)))
+	}
+	if u := info.Upstream; u != nil {
+		line("upstream.service", u.Service)
+		line("upstream.code", u.Code)
+		if u.Status != 0 {
+			line("upstream.status", strconv.Itoa(u.Status))
+		}
+	}
+	if s := info.Service; s != nil {
+		line("service.name", s.Name)
+		line("service.version", s.Version)
+		line("service.env", s.Env)
+	}
+	line("cancel_cause", info.CancelCause)
+	for _, k := range sortedKeys(info.Labels) {
+		line("label."+k, info.Labels[k])
+	}
+	for _, k := range sortedKeys(info.Context) {
+		line("context."+k, localize(info.Context[k]))
+	}
+	for _, k := range sortedKeys(info.Fields) {
+		line("field."+k, localize(info.Fields[k]))
+	}
+	if len(info.Expectations) > 0 {
+		var table strings.Builder
+		tw := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+		fmt.Fprint(tw, "field\texpected\tactual")
+		for _, m := range info.Expectations {
+			fmt.Fprintf(tw, "\n%s\t%s\t%s", m.Field, ciValue(m.Expected), ciValue(m.Actual))
+		}
+		tw.Flush()
+		b.WriteByte('\n')
+		fmt.Fprintf(&b, "%-*s %s", ciKeyWidth, "expectations:",
+			strings.ReplaceAll(table.String(), "\n", "\n"+strings.Repeat(" ", ciKeyWidth+1)))
+	}
+
+	return []byte(b.String()), nil
+}
+
+// ciValue formats v for OutputCI, quoting strings so empty strings are visible.
+func ciValue(v any) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return localize(v)
+}
+
+// moduleRoots caches the module root directory of source directories.
+var moduleRoots sync.Map
+
+// moduleRelative returns file relative to the root of its module, the
+// closest directory with a go.mod file, or file if it has none.
+// Files trimmed of the parent of the errific module are resolved against it.
+func moduleRelative(file string) string {
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+
+	dir := filepath.Dir(path)
+	moduleRoot, ok := moduleRoots.Load(dir)
+	if !ok {
+		moduleRoot = ""
+		for d := dir; ; d = filepath.Dir(d) {
+			if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+				moduleRoot = d
+				break
+			}
+			if filepath.Dir(d) == d {
+				break
+			}
+		}
+		moduleRoots.Store(dir, moduleRoot)
+	}
+
+	if moduleRoot == "" {
+		return file
+	}
+	rel, err := filepath.Rel(moduleRoot.(string), path)
+	if err != nil {
+		return file
+	}
+	return filepath.ToSlash(rel)
+}
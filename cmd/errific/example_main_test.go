@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const stream = `{"message":"error querying thing","caller":"things/query.go:12.Query","code":"QUERY_001","category":"server","correlation_id":"corr-1","wrapped":["EOF","connection reset\nby peer"],"stack":"\n  things/query.go:12.Query\n  things/handler.go:30.Handle"}
+not json
+{"level":"error","error":{"message":"thing not found","code":"THING_404","category":"not_found","labels":{"region":"us-east-1"},"context":{"id":"abc"}}}
+{"message":"error querying thing","code":"QUERY_002","category":"timeout"}
+{"level":"info","msg":"no error"}
+`
+
+func explore() *explorer {
+	x := &explorer{out: os.Stdout}
+	x.tail(bufio.NewReader(strings.NewReader(stream)), false, nil)
+	return x
+}
+
+func Example_decode() {
+	for _, line := range strings.Split(strings.TrimSpace(stream), "\n") {
+		e, ok := decode([]byte(line))
+		fmt.Println(ok, e.Code, e.Context["id"])
+	}
+
+	// Output:
+	// true QUERY_001 <nil>
+	// false  <nil>
+	// true THING_404 abc
+	// true QUERY_002 <nil>
+	// false  <nil>
+}
+
+func Example_list() {
+	x := explore()
+	x.run("list", nil)
+	x.run("list", []string{"code=THING_404"})
+	x.run("list", []string{"category=timeout"})
+	x.run("codes", nil)
+
+	// Output:
+	//    0  QUERY_001            server       error querying thing
+	//    1  THING_404            not_found    thing not found
+	//    2  QUERY_002            timeout      error querying thing
+	//    1  THING_404            not_found    thing not found
+	//    2  QUERY_002            timeout      error querying thing
+	//      1  QUERY_001
+	//      1  QUERY_002
+	//      1  THING_404
+}
+
+func Example_show() {
+	x := explore()
+	x.run("show", []string{"0"})
+	x.run("show", []string{"1"})
+	x.run("show", []string{"3"})
+
+	// Output:
+	// error querying thing
+	//   caller:         things/query.go:12.Query
+	//   code:           QUERY_001
+	//   category:       server
+	//   correlation_id: corr-1
+	//   wrapped:
+	//     EOF
+	//     connection reset
+	//     by peer
+	//   stack:
+	//     things/query.go:12.Query
+	//     things/handler.go:30.Handle
+	// thing not found
+	//   code:           THING_404
+	//   category:       not_found
+	//   label.region:   us-east-1
+	//   context.id:     abc
+	// no error 3
+}
+
+func Example_follow() {
+	f, _ := os.CreateTemp("", "errific-*.log")
+	defer os.Remove(f.Name())
+	fmt.Fprint(f, stream)
+
+	x := &explorer{out: os.Stdout}
+	r, _ := os.Open(f.Name())
+	defer r.Close()
+	caughtUp := make(chan struct{})
+	go x.tail(bufio.NewReader(r), true, func() { close(caughtUp) })
+	<-caughtUp
+	x.run("list", []string{"code=QUERY_002"})
+
+	fmt.Fprintln(f, `{"message":"error querying thing","code":"QUERY_003"}`)
+	for {
+		x.mu.Lock()
+		n := len(x.entries)
+		x.mu.Unlock()
+		if n == 4 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	x.run("list", []string{"code=QUERY_003"})
+
+	// Output:
+	//    2  QUERY_002            timeout      error querying thing
+	//    3  QUERY_003                         error querying thing
+}
@@ -0,0 +1,316 @@
+// Command errific explores errors in a JSON log stream.
+//
+// It tails a file of newline delimited JSON errors, as written by
+// json.Marshal of errific errors, either as the log line or under its
+// "error" key, and reads commands from stdin to navigate them.
+//
+//	errific -f service.log
+//
+// With the symbolize subcommand, it resolves a stack captured with
+// errific.PCStack read from stdin, using a build of the binary with symbols.
+//
+//	errific symbolize bin/server.debug < stack.txt
+//
+// Commands:
+//
+//	codes              count errors by code
+//	categories         count errors by category
+//	list [key=value]   list errors, filtered by code or category
+//	show N             expand the chain and stack of error N
+//	copy N             copy the correlation ID of error N to the clipboard
+//	help               print commands
+//	quit               exit
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// entry is an error decoded from the log stream.
+type entry struct {
+	errific.ErrorInfo
+	Wrapped []string `json:"wrapped,omitempty"`
+	Stack   string   `json:"stack,omitempty"`
+}
+
+type explorer struct {
+	mu      sync.Mutex
+	entries []entry
+	out     io.Writer
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "symbolize" {
+		symbolize(os.Args[2:])
+		return
+	}
+
+	follow := flag.Bool("f", false, "follow the file as it grows")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: errific [-f] file\n       errific symbolize symbolfile < stack")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	x := &explorer{out: os.Stdout}
+	br := bufio.NewReader(f)
+	if *follow {
+		caughtUp := make(chan struct{})
+		go x.tail(br, true, func() { close(caughtUp) })
+		<-caughtUp
+	} else {
+		x.tail(br, false, nil)
+	}
+	x.repl(os.Stdin)
+}
+
+// symbolize writes the stack read from stdin resolved with the symbol file of args.
+func symbolize(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: errific symbolize symbolfile < stack")
+		os.Exit(2)
+	}
+
+	stack, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	symbolized, err := errific.Symbolize(string(stack), args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(symbolized)
+}
+
+// tail decodes errors from br until EOF, or polls for more when follow
+// is set, calling caughtUp, if not nil, at the first EOF.
+// Lines that are not JSON errors are skipped.
+func (x *explorer) tail(br *bufio.Reader, follow bool, caughtUp func()) {
+	var partial string
+	for {
+		line, err := br.ReadString('\n')
+		partial += line
+		if err == io.EOF && caughtUp != nil {
+			caughtUp()
+			caughtUp = nil
+		}
+		if err == io.EOF && follow {
+			// keep a partial line until it is complete
+			time.Sleep(250 * time.Millisecond)
+			continue
+		}
+		if err == nil || partial != "" {
+			if e, ok := decode([]byte(partial)); ok {
+				x.mu.Lock()
+				x.entries = append(x.entries, e)
+				x.mu.Unlock()
+			}
+			partial = ""
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// decode returns the error of a log line, or of its "error" key.
+func decode(line []byte) (entry, bool) {
+	var nested struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(line, &nested) == nil && len(nested.Error) > 0 && nested.Error[0] == '{' {
+		line = nested.Error
+	}
+
+	var e entry
+	if err := json.Unmarshal(line, &e); err != nil || e.Message == "" {
+		return e, false
+	}
+	if info, err := errific.FromJSON(line); err == nil {
+		e.Context = info.Context
+	}
+	return e, true
+}
+
+func (x *explorer) repl(r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	fmt.Fprint(x.out, "errific> ")
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 {
+			if fields[0] == "quit" || fields[0] == "q" {
+				return
+			}
+			x.run(fields[0], fields[1:])
+		}
+		fmt.Fprint(x.out, "errific> ")
+	}
+}
+
+func (x *explorer) run(cmd string, args []string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	switch cmd {
+	case "codes":
+		x.count(func(e entry) string { return e.Code })
+	case "categories":
+		x.count(func(e entry) string { return string(e.Category) })
+	case "list", "ls":
+		x.list(args)
+	case "show":
+		if e, ok := x.entry(args); ok {
+			x.show(e)
+		}
+	case "copy":
+		if e, ok := x.entry(args); ok {
+			x.copy(e)
+		}
+	default:
+		fmt.Fprintln(x.out, "commands: codes, categories, list [code=C|category=C], show N, copy N, quit")
+	}
+}
+
+func (x *explorer) count(key func(entry) string) {
+	counts := map[string]int{}
+	for _, e := range x.entries {
+		counts[key(e)]++
+	}
+
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, k := range keys {
+		name := k
+		if name == "" {
+			name = "(none)"
+		}
+		fmt.Fprintf(x.out, "%6d  %s\n", counts[k], name)
+	}
+}
+
+func (x *explorer) list(args []string) {
+	var key, value string
+	if len(args) > 0 {
+		key, value, _ = strings.Cut(args[0], "=")
+	}
+
+	for i, e := range x.entries {
+		switch {
+		case key == "code" && e.Code != value:
+			continue
+		case key == "category" && string(e.Category) != value:
+			continue
+		}
+		fmt.Fprintf(x.out, "%4d  %-20s %-12s %s\n", i, e.Code, e.Category, e.Message)
+	}
+}
+
+func (x *explorer) entry(args []string) (entry, bool) {
+	if len(args) == 0 {
+		fmt.Fprintln(x.out, "missing error number")
+		return entry{}, false
+	}
+	i, err := strconv.Atoi(args[0])
+	if err != nil || i < 0 || i >= len(x.entries) {
+		fmt.Fprintf(x.out, "no error %s\n", args[0])
+		return entry{}, false
+	}
+	return x.entries[i], true
+}
+
+func (x *explorer) show(e entry) {
+	fmt.Fprintln(x.out, e.Message)
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(x.out, "  %-15s %s\n", name+":", value)
+		}
+	}
+	field("caller", e.Caller)
+	field("code", e.Code)
+	field("category", string(e.Category))
+	field("correlation_id", e.CorrelationID)
+	field("request_id", e.RequestID)
+	field("tenant", e.Tenant)
+	if e.HTTPStatus != 0 {
+		field("http_status", strconv.Itoa(e.HTTPStatus))
+	}
+	if ref := e.LogRef; ref != nil {
+		field("log_ref", ref.Stream+"@"+ref.Offset)
+	}
+	field("cancel_cause", e.CancelCause)
+	for _, k := range sortedKeys(e.Labels) {
+		field("label."+k, e.Labels[k])
+	}
+	for _, k := range sortedKeys(e.Context) {
+		field("context."+k, fmt.Sprint(e.Context[k]))
+	}
+
+	if len(e.Wrapped) > 0 {
+		fmt.Fprintln(x.out, "  wrapped:")
+		for _, wrapped := range e.Wrapped {
+			fmt.Fprintf(x.out, "    %s\n", strings.ReplaceAll(wrapped, "\n", "\n    "))
+		}
+	}
+	if e.Stack != "" {
+		fmt.Fprintln(x.out, "  stack:")
+		for _, line := range strings.Split(strings.TrimSpace(e.Stack), "\n") {
+			fmt.Fprintf(x.out, "    %s\n", strings.TrimSpace(line))
+		}
+	}
+}
+
+// copy writes the correlation ID of e to the clipboard
+// with an OSC 52 terminal escape sequence, and prints it.
+func (x *explorer) copy(e entry) {
+	if e.CorrelationID == "" {
+		fmt.Fprintln(x.out, "no correlation_id")
+		return
+	}
+	fmt.Fprintf(x.out, "\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(e.CorrelationID)))
+	fmt.Fprintf(x.out, "copied %s\n", e.CorrelationID)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
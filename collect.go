@@ -0,0 +1,92 @@
+package errific
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+type collectorKey struct{}
+
+type collector struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+// AccessLog logs a request with its response status and
+// the errors reported while handling it.
+type AccessLog func(r *http.Request, status int, errs []error)
+
+// CollectErrors returns an http.Handler that collects the errors reported
+// with AddError while next handles a request, including handled errors,
+// and passes them to log with the final response status.
+//
+//	http.ListenAndServe(":8080", errific.CollectErrors(mux, func(r *http.Request, status int, errs []error) {
+//		slog.Info("request", "path", r.URL.Path, "status", status, "errors", errs)
+//	}))
+func CollectErrors(next http.Handler, log AccessLog) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		col := &collector{}
+		r = r.WithContext(context.WithValue(r.Context(), collectorKey{}, col))
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		if log != nil {
+			log(r, sw.status, ErrorsFromRequest(r.Context()))
+		}
+	})
+}
+
+// AddError reports err for the request of ctx collected by CollectErrors.
+// Nil errors, and contexts without a collector, are ignored.
+//
+//	if err := cache.Set(ctx, key, v); err != nil {
+//		errific.AddError(ctx, err) // handled, but still reported.
+//	}
+func AddError(ctx context.Context, err error) {
+	col, ok := ctx.Value(collectorKey{}).(*collector)
+	if !ok || err == nil {
+		return
+	}
+	col.mu.Lock()
+	defer col.mu.Unlock()
+	col.errs = append(col.errs, err)
+}
+
+// ErrorsFromRequest returns a copy of the errors reported for the request
+// of ctx collected by CollectErrors, in the order reported.
+func ErrorsFromRequest(ctx context.Context) []error {
+	col, ok := ctx.Value(collectorKey{}).(*collector)
+	if !ok {
+		return nil
+	}
+	col.mu.Lock()
+	defer col.mu.Unlock()
+	return append([]error(nil), col.errs...)
+}
+
+// statusWriter records the status written to a ResponseWriter.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped ResponseWriter for http.ResponseController.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
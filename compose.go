@@ -0,0 +1,118 @@
+package errific
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ComposeOption sets a component of an error built with Compose.
+type ComposeOption struct {
+	apply func(*errific)
+}
+
+var (
+	// ComposeCaller sets the caller of the error, formatted as file:line.function.
+	ComposeCaller = func(caller string) ComposeOption {
+		return ComposeOption{func(e *errific) { e.caller = caller }}
+	}
+
+	// ComposeStack sets the stack of the error to frames, innermost call first.
+	ComposeStack = func(frames ...Frame) ComposeOption {
+		return ComposeOption{func(e *errific) {
+			e.stack = nil
+			for _, f := range frames {
+				e.stack = append(e.stack, fmt.Sprintf("\n  %s:%d.%s", f.File, f.Line, f.Function)...)
+			}
+		}}
+	}
+
+	// ComposeWrapped wraps errs, which are joined in Error() and satisfy errors.Is.
+	ComposeWrapped = func(errs ...error) ComposeOption {
+		return ComposeOption{func(e *errific) { e.errs = append(e.errs, normalize(errs)...) }}
+	}
+
+	// ComposeInfo sets the metadata of the error, and its caller if set,
+	// from info, such as decoded with FromJSON. The message of info is not
+	// used, and only the first of its Expectations is set.
+	ComposeInfo = func(info ErrorInfo) ComposeOption {
+		return ComposeOption{func(e *errific) {
+			if info.Caller != "" {
+				e.caller = info.Caller
+			}
+			e.code = info.Code
+			e.category = info.Category
+			e.correlationID = info.CorrelationID
+			e.requestID = info.RequestID
+			e.tenant = info.Tenant
+			e.httpStatus = info.HTTPStatus
+			e.retryable = info.Retryable
+			e.retryAfter = time.Duration(info.RetryAfter)
+			if len(info.Labels) > 0 {
+				e.labels = make(map[string]string, len(info.Labels))
+				for k, v := range info.Labels {
+					e.labels[k] = v
+				}
+			}
+			if len(info.Context) > 0 {
+				e.context = make(map[string]any, len(info.Context))
+				for k, v := range info.Context {
+					e.context[k] = v
+				}
+			}
+			if u := info.Upstream; u != nil {
+				e.upstream = &Upstream{Service: u.Service, Code: u.Code, Status: u.Status}
+			}
+			if s := info.Service; s != nil {
+				service := *s
+				e.service = &service
+			}
+			if cl := info.Classification; cl != nil {
+				classification := *cl
+				e.classification = &classification
+			}
+			if d := info.Deprecation; d != nil {
+				deprecation := *d
+				e.deprecation = &deprecation
+			}
+			if r := info.LogRef; r != nil {
+				ref := *r
+				e.logRef = &ref
+			}
+			if len(info.Fields) > 0 {
+				e.fields = make(map[string]any, len(info.Fields))
+				for k, v := range info.Fields {
+					e.fields[k] = v
+				}
+			}
+			if len(info.Docs) > 0 {
+				e.docs = append([]Doc(nil), info.Docs...)
+			}
+			if len(info.Expectations) > 0 {
+				m := info.Expectations[0]
+				e.mismatch = &m
+			}
+			if info.CancelCause != "" {
+				e.cancelCause = errors.New(info.CancelCause)
+			}
+		}}
+	}
+)
+
+// Compose returns an error using msg as text built from components, for
+// deserializers, log replay tools, and bridges from other error systems.
+// Unlike New, no caller or stack is captured and no ServiceIdentity is
+// stamped, only the components of opts are set. The error satisfies
+// errors.Is for Err(msg).
+//
+//	info, _ := errific.FromJSON(line)
+//	err := errific.Compose(info.Message, errific.ComposeInfo(info))
+func Compose(msg string, opts ...ComposeOption) error {
+	e := errific{err: Err(msg)}
+	for _, opt := range opts {
+		if opt.apply != nil {
+			opt.apply(&e)
+		}
+	}
+	return e
+}
@@ -2,8 +2,9 @@ package errific
 
 import (
 	"fmt"
-	"os"
+	"log/slog"
 	"path/filepath"
+	"regexp"
 	"runtime"
 )
 
@@ -13,8 +14,33 @@ func Configure(opts ...Option) {
 	c.caller = Suffix
 	c.layout = Newline
 	c.withStack = false
+	c.stackMode = InheritStack
 	c.trimPrefixes = nil
 	c.trimCWD = false
+	c.inheritMetadata = false
+	c.precedence = nil
+	c.secrets = nil
+	c.maxJSONSize = 0
+	c.compressContext = 0
+	c.levels = nil
+	c.panicOnMissing = false
+	c.output = ""
+	c.service = nil
+	c.countCallSites = false
+	c.mappings = Mappings{}
+	c.policy = Policy{}
+	c.httpView = ViewExternal
+	c.docsURL = ""
+	c.shadow = nil
+	c.noCapture = false
+	c.pcStack = false
+	c.flagNilErrors = false
+	c.chainStats = false
+	c.funcFormat = ShortFunc
+	c.originExpr = false
+	c.locale = Locale{}
+	c.reporters = nil
+	c.reportCreated = false
 
 	for _, opt := range opts {
 		switch o := opt.(type) {
@@ -27,25 +53,134 @@ func Configure(opts ...Option) {
 		case withStackTraceOption:
 			c.withStack = o
 
+		case stackOption:
+			c.stackMode = o
+
 		case trimPrefixesOption:
 			c.trimPrefixes = o.Prefixes()
 
 		case trimCWDOption:
 			c.trimCWD = o
+
+		case inheritMetadataOption:
+			c.inheritMetadata = o
+
+		case resolveOption:
+			if c.precedence == nil {
+				c.precedence = map[Field]precedence{}
+			}
+			for _, field := range o.fields {
+				c.precedence[field] = o.precedence
+			}
+
+		case maskSecretsOption:
+			c.secrets = append(c.secrets, o.patterns...)
+
+		case maxJSONSizeOption:
+			c.maxJSONSize = int(o)
+
+		case compressContextOption:
+			c.compressContext = int(o)
+
+		case mappingsOption:
+			c.mappings = Mappings(o)
+
+		case policyOption:
+			c.policy = Policy(o)
+
+		case httpViewOption:
+			c.httpView = view(o)
+
+		case docsURLOption:
+			c.docsURL = string(o)
+
+		case *shadowOption:
+			c.shadow = o
+
+		case callerFormatOption:
+			c.funcFormat = funcFormat(o)
+
+		case reportersOption:
+			c.reporters = append(c.reporters, o...)
+
+		case reportCreatedOption:
+			c.reportCreated = o
+
+		case localizeOption:
+			c.locale = Locale(o)
+
+		case originExprOption:
+			c.originExpr = o
+
+		case chainStatsOption:
+			c.chainStats = o
+
+		case flagNilErrorsOption:
+			c.flagNilErrors = o
+
+		case pcStackOption:
+			c.pcStack = o
+
+		case noCaptureOption:
+			c.noCapture = o
+
+		case countCallSitesOption:
+			c.countCallSites = o
+
+		case serviceIdentityOption:
+			service := Service(o)
+			c.service = &service
+
+		case outputOption:
+			c.output = o
+
+		case panicOnMissingMetadataOption:
+			c.panicOnMissing = o
+
+		case logLevelOption:
+			if c.levels == nil {
+				c.levels = map[Category]slog.Level{}
+			}
+			for _, category := range o.categories {
+				c.levels[category] = o.level
+			}
 		}
 	}
 
 	if c.trimCWD {
-		cwd, err := os.Getwd()
+		cwd, err := getwd()
 		if err != nil {
 			panic(err)
 		}
 
-		c.trimPrefixes = append([]string{filepath.Dir(cwd) + "/"}, c.trimPrefixes...)
+		if cwd != "" {
+			c.trimPrefixes = append([]string{filepath.Dir(cwd) + "/"}, c.trimPrefixes...)
+		}
 	}
 }
 
-var c struct {
+// Snapshot is the configuration of errific at a point in time.
+type Snapshot struct {
+	c config
+}
+
+// CurrentConfig returns a Snapshot of the configuration,
+// for restoring it after configuring errific temporarily.
+//
+//	defer errific.CurrentConfig().Restore()
+//	errific.Configure(errific.WithStack)
+func CurrentConfig() Snapshot {
+	return Snapshot{c: c}
+}
+
+// Restore the configuration of s.
+func (s Snapshot) Restore() {
+	c = s.c
+}
+
+var c config
+
+type config struct {
 	// Caller will configure the caller: Suffix|Prefix|Disabled.
 	// Default is Suffix.
 	caller callerOption
@@ -55,11 +190,89 @@ var c struct {
 	// WithStack will append stacktrace to end of message.
 	// Default is not including the stack.
 	withStack withStackTraceOption
+	// StackMode will configure how wrapping errors use the stack of wrapped
+	// errors: InheritStack|CaptureNewStack|AppendStacks.
+	// Default is InheritStack.
+	stackMode stackOption
 	// TrimPrefixes will trim prefixes from caller frame filenames.
 	trimPrefixes []string
 	// TrimCWD will trim the current working directory from filenames.
 	// Default is false.
 	trimCWD trimCWDOption
+	// InheritMetadata will copy correlation ID, request ID, tenant,
+	// and labels from wrapped errors onto the wrapping error.
+	// Default is false.
+	inheritMetadata inheritMetadataOption
+	// Precedence will configure which error of a chain a field resolves from.
+	// Default is Outermost.
+	precedence map[Field]precedence
+	// Secrets will mask matches of the patterns in error messages.
+	// Default is not masking.
+	secrets []*regexp.Regexp
+	// MaxJSONSize will drop fields from JSON output exceeding the size in bytes.
+	// Default is no limit.
+	maxJSONSize int
+	// CompressContext will compress JSON context exceeding the size in bytes.
+	// Default is no compression.
+	compressContext int
+	// Levels will configure the slog.Level of categories for LogLevel.
+	// Default is WARN for client errors and ERROR for server errors.
+	levels map[Category]slog.Level
+	// PanicOnMissing will panic in the MustGet functions instead of returning errors.
+	// Default is false.
+	panicOnMissing panicOnMissingMetadataOption
+	// Output will format Error() with a format registered with RegisterFormat.
+	// Default is text.
+	output outputOption
+	// Service will stamp the origin service identity on created errors.
+	// Default is no identity.
+	service *Service
+	// CountCallSites will count created errors by caller for TopCallSites.
+	// Default is false.
+	countCallSites countCallSitesOption
+	// Mappings will configure the protocol codes of converters.
+	// Default is DefaultMappings.
+	mappings Mappings
+	// Policy will decide what to do with errors for Decide.
+	// Default is retrying retryable errors and escalating others.
+	policy Policy
+	// HTTPView will select the body of errors written by WriteHTTP.
+	// Default is ViewExternal.
+	httpView view
+	// DocsURL will be the base URL of the type of ProblemDetails.
+	// Default is "about:blank" types.
+	docsURL string
+	// Shadow will compare the output of sampled errors with a candidate format.
+	// Default is no shadow format.
+	shadow *shadowOption
+	// NoCapture will not capture callers or stacks.
+	// Default is capturing.
+	noCapture noCaptureOption
+	// PCStack will capture stacks as program counter offsets for Symbolize.
+	// Default is file:line frames.
+	pcStack pcStackOption
+	// FlagNilErrors will replace nil wrapped errors with ErrNilWrapped.
+	// Default is dropping them.
+	flagNilErrors flagNilErrorsOption
+	// ChainStats will include the chain depth and wrap count in JSON output.
+	// Default is false.
+	chainStats chainStatsOption
+	// FuncFormat will configure the function name of callers and stack
+	// frames: ShortFunc|PkgAndFunc|FullFunc.
+	// Default is ShortFunc.
+	funcFormat funcFormat
+	// OriginExpr will record the source expression of wrapped errors in context.
+	// Default is false.
+	originExpr originExprOption
+	// Locale will format durations, numbers, and timestamps of human readable output.
+	// Default is fmt formatting.
+	locale Locale
+	// Reporters will receive the errors passed to Report.
+	// Default is no reporters.
+	reporters []Reporter
+	// ReportCreated will dispatch errors to the Reporters when created.
+	// Default is false.
+	reportCreated reportCreatedOption
 }
 
 type callerOption int
@@ -97,6 +310,90 @@ const (
 	WithStack withStackTraceOption = true
 )
 
+type stackOption int
+
+func (stackOption) ErrificOption() {}
+
+// stackSeparator separates the stacks concatenated by AppendStacks.
+const stackSeparator = "\n  ---"
+
+const (
+	// InheritStack reuses the stack of a wrapped error instead of capturing one.
+	// This is default.
+	InheritStack stackOption = iota
+	// CaptureNewStack captures the stack of the wrapping error.
+	CaptureNewStack
+	// AppendStacks captures the stack of the wrapping error
+	// followed by the stack of a wrapped error.
+	AppendStacks
+)
+
+type funcFormat int
+
+const (
+	// ShortFunc formats the last element of the function name, Method.
+	// This is default.
+	ShortFunc funcFormat = iota
+	// PkgAndFunc formats the package name and function, pkg.(*T).Method.
+	PkgAndFunc
+	// FullFunc formats the function with its package import path,
+	// github.com/org/repo/pkg.(*T).Method.
+	FullFunc
+)
+
+type callerFormatOption funcFormat
+
+func (callerFormatOption) ErrificOption() {}
+
+var (
+	// CallerFormat of the function name of callers and stack frames:
+	// ShortFunc|PkgAndFunc|FullFunc.
+	// File paths are trimmed with TrimPrefixes and TrimCWD.
+	//
+	//	errific.Configure(errific.CallerFormat(errific.PkgAndFunc))
+	CallerFormat = func(f funcFormat) callerFormatOption {
+		return callerFormatOption(f)
+	}
+)
+
+type chainStatsOption bool
+
+func (chainStatsOption) ErrificOption() {}
+
+const (
+	// ChainStats includes the Depth and WrapCount of the error chain
+	// in JSON output, so monitoring can flag suspiciously deep chains.
+	//
+	//	errific.Configure(errific.ChainStats)
+	ChainStats chainStatsOption = true
+)
+
+type flagNilErrorsOption bool
+
+func (flagNilErrorsOption) ErrificOption() {}
+
+const (
+	// FlagNilErrors replaces nil errors passed to New and Join with
+	// ErrNilWrapped instead of dropping them, to catch accidental nil
+	// wrapping in development.
+	//
+	//	errific.Configure(errific.FlagNilErrors)
+	FlagNilErrors flagNilErrorsOption = true
+)
+
+type pcStackOption bool
+
+func (pcStackOption) ErrificOption() {}
+
+const (
+	// PCStack captures stack frames as program counter offsets instead of
+	// file:line frames, for binaries built with -trimpath or -ldflags="-s -w".
+	// Resolve the offsets with Symbolize and an unstripped build of the binary.
+	//
+	//	errific.Configure(errific.WithStack, errific.PCStack)
+	PCStack pcStackOption = true
+)
+
 type trimPrefixesOption struct {
 	prefixes []string
 }
@@ -123,13 +420,116 @@ const (
 	TrimCWD trimCWDOption = true
 )
 
+type inheritMetadataOption bool
+
+func (inheritMetadataOption) ErrificOption() {}
+
+const (
+	// Inherit correlation ID, request ID, tenant, and labels from wrapped errors.
+	InheritMetadata inheritMetadataOption = true
+)
+
+type maskSecretsOption struct {
+	patterns []*regexp.Regexp
+}
+
+func (maskSecretsOption) ErrificOption() {}
+
+var (
+	// MaskSecrets matching patterns in error messages, including wrapped errors.
+	// Without patterns SecretPatterns are masked.
+	//
+	//	errific.Configure(errific.MaskSecrets())
+	MaskSecrets = func(patterns ...*regexp.Regexp) maskSecretsOption {
+		if len(patterns) == 0 {
+			patterns = SecretPatterns
+		}
+		return maskSecretsOption{patterns: patterns}
+	}
+)
+
+type maxJSONSizeOption int
+
+func (maxJSONSizeOption) ErrificOption() {}
+
+var (
+	// MaxJSONSize in bytes, dropping stack, context, labels,
+	// and wrapped messages from JSON output until it fits.
+	//
+	//	errific.Configure(errific.MaxJSONSize(8 << 10))
+	MaxJSONSize = func(n int) maxJSONSizeOption {
+		return maxJSONSizeOption(n)
+	}
+)
+
+type panicOnMissingMetadataOption bool
+
+func (panicOnMissingMetadataOption) ErrificOption() {}
+
+const (
+	// Panic in the MustGet functions when metadata is missing, for tests.
+	PanicOnMissingMetadata panicOnMissingMetadataOption = true
+)
+
+type serviceIdentityOption Service
+
+func (serviceIdentityOption) ErrificOption() {}
+
+var (
+	// ServiceIdentity stamps the name, version, and env
+	// of the service on every error created.
+	//
+	//	errific.Configure(errific.ServiceIdentity("billing", "v1.4.2", "production"))
+	ServiceIdentity = func(name, version, env string) serviceIdentityOption {
+		return serviceIdentityOption{Name: name, Version: version, Env: env}
+	}
+)
+
+type countCallSitesOption bool
+
+func (countCallSitesOption) ErrificOption() {}
+
+const (
+	// Count created errors by caller, see TopCallSites.
+	CountCallSites countCallSitesOption = true
+)
+
+type compressContextOption int
+
+func (compressContextOption) ErrificOption() {}
+
+var (
+	// CompressContext in JSON output with gzip when larger than threshold bytes.
+	// FromJSON decompresses it.
+	//
+	//	errific.Configure(errific.CompressContext(4 << 10))
+	CompressContext = func(threshold int) compressContextOption {
+		return compressContextOption(threshold)
+	}
+)
+
+type noCaptureOption bool
+
+func (noCaptureOption) ErrificOption() {}
+
+const (
+	// NoCapture does not capture callers or stacks with runtime.Callers,
+	// for platforms such as tinygo where frames are unavailable or costly
+	// to symbolize. Errors have no caller and Error() omits it.
+	//
+	//	errific.Configure(errific.NoCapture)
+	NoCapture noCaptureOption = true
+)
+
 type Option interface {
 	ErrificOption()
 }
 
-var root string
+var root, goroot string
 
 func init() {
 	_, file, _, _ := runtime.Caller(0)
 	root = fmt.Sprintf("%s/", filepath.Join(filepath.Dir(file), ".."))
+	// GOROOT is empty in binaries built with -trimpath and on js/wasm.
+	goroot = runtime.GOROOT()
 }
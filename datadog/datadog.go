@@ -0,0 +1,101 @@
+// Package datadog formats errific errors for Datadog logs and traces.
+//
+// The package depends only on the SpanTagger interface, so importing it
+// does not add the Datadog tracer to binaries that only format log entries.
+// Spans of dd-trace-go satisfy SpanTagger.
+package datadog
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+)
+
+// SpanTagger is the subset of a Datadog span used by TagSpan.
+type SpanTagger interface {
+	SetTag(key string, value interface{})
+}
+
+// LogEntry is a log entry with Datadog standard error attributes.
+// Service, Version, and Env are set from errific.ServiceIdentity.
+type LogEntry struct {
+	Status       string            `json:"status"`
+	Message      string            `json:"message"`
+	Service      string            `json:"service,omitempty"`
+	Version      string            `json:"version,omitempty"`
+	Env          string            `json:"env,omitempty"`
+	ErrorKind    string            `json:"error.kind,omitempty"`
+	ErrorMessage string            `json:"error.message"`
+	ErrorStack   string            `json:"error.stack,omitempty"`
+	Errific      errific.ErrorInfo `json:"errific"`
+}
+
+// NewLogEntry returns the LogEntry of err.
+//
+//	json.NewEncoder(os.Stdout).Encode(datadog.NewLogEntry(err))
+func NewLogEntry(err error) LogEntry {
+	info := errific.ResolveChain(err)
+	entry := LogEntry{
+		Status:       "error",
+		Message:      info.Message,
+		ErrorKind:    info.Code,
+		ErrorMessage: err.Error(),
+		Errific:      info,
+	}
+
+	if entry.ErrorKind == "" {
+		entry.ErrorKind = fmt.Sprintf("%T", err)
+	}
+
+	if svc := info.Service; svc != nil {
+		entry.Service = svc.Name
+		entry.Version = svc.Version
+		entry.Env = svc.Env
+	}
+
+	return entry
+}
+
+// TagSpan sets the Datadog error tags and the errific metadata of err
+// on span, with the code and category of each level of the chain as
+// error.chain.N.code and error.chain.N.category. Nil errors are ignored.
+//
+//	datadog.TagSpan(span, err)
+func TagSpan(span SpanTagger, err error) {
+	if err == nil {
+		return
+	}
+
+	entry := NewLogEntry(err)
+	span.SetTag("error", true)
+	span.SetTag("error.message", entry.ErrorMessage)
+	span.SetTag("error.type", entry.ErrorKind)
+
+	info := entry.Errific
+	tags := map[string]string{
+		"errific.code":           info.Code,
+		"errific.category":       string(info.Category),
+		"errific.caller":         info.Caller,
+		"errific.correlation_id": info.CorrelationID,
+		"errific.request_id":     info.RequestID,
+		"errific.tenant":         info.Tenant,
+	}
+	for k, v := range tags {
+		if v != "" {
+			span.SetTag(k, v)
+		}
+	}
+	if info.HTTPStatus != 0 {
+		span.SetTag("errific.http_status", info.HTTPStatus)
+	}
+	if info.Retryable {
+		span.SetTag("errific.retryable", true)
+	}
+	for k, v := range info.Labels {
+		span.SetTag("errific.label."+k, v)
+	}
+	for i, level := range errific.Chain(err) {
+		span.SetTag(fmt.Sprintf("error.chain.%d.code", i), level.Code)
+		span.SetTag(fmt.Sprintf("error.chain.%d.category", i), string(level.Category))
+	}
+}
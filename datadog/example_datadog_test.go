@@ -0,0 +1,72 @@
+package datadog_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/datadog"
+)
+
+type span map[string]interface{}
+
+func (s span) SetTag(key string, value interface{}) { s[key] = value }
+
+func ExampleTagSpan() {
+	errific.Configure() // default configuration
+	var ErrQuery errific.Err = "error querying thing"
+
+	s := span{}
+	datadog.TagSpan(s, ErrQuery.New().WithCode("QUERY_001").WithHTTPStatus(503))
+
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if k != "errific.caller" && k != "error.message" && !strings.HasPrefix(k, "error.chain.") {
+			fmt.Println(k, s[k])
+		}
+	}
+
+	// Output:
+	// errific.code QUERY_001
+	// errific.http_status 503
+	// error true
+	// error.type QUERY_001
+}
+
+func ExampleTagSpan_chain() {
+	errific.Configure() // default configuration
+	var ErrQuery errific.Err = "error querying thing"
+	var ErrHandle errific.Err = "error handling request"
+
+	s := span{}
+	cause := ErrQuery.New().WithCode("QUERY_001").WithCategory(errific.CategoryTimeout)
+	datadog.TagSpan(s, ErrHandle.New(cause).WithCode("HANDLE_001").WithCategory(errific.CategoryServer))
+
+	for i := 0; i < 2; i++ {
+		fmt.Println(s[fmt.Sprintf("error.chain.%d.code", i)], s[fmt.Sprintf("error.chain.%d.category", i)])
+	}
+	_, ok := s["error.chain.2.code"]
+	fmt.Println(ok)
+
+	// Output:
+	// HANDLE_001 server
+	// QUERY_001 timeout
+	// false
+}
+
+func ExampleNewLogEntry() {
+	errific.Configure(errific.ServiceIdentity("billing", "v1.4.2", "production"))
+	defer errific.Configure()
+	var ErrCharge errific.Err = "error charging payment"
+
+	entry := datadog.NewLogEntry(ErrCharge.New().WithCode("PAYMENT_001"))
+	fmt.Println(entry.Service, entry.Version, entry.Env, entry.ErrorKind, entry.Message)
+
+	// Output:
+	// billing v1.4.2 production PAYMENT_001 error charging payment
+}
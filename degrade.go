@@ -0,0 +1,174 @@
+package errific
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Errors reported by Degrader on rule state transitions.
+var (
+	ErrDegraded Err = "degraded on sustained errors"
+	ErrRestored Err = "restored from degradation"
+)
+
+// Context keys set by Degrader transition errors.
+const (
+	ContextDegradeRule   = "degrade_rule"
+	ContextDegradeCode   = "degrade_code"
+	ContextDegradeCount  = "degrade_count"
+	ContextDegradeLimit  = "degrade_limit"
+	ContextDegradeWindow = "degrade_window"
+)
+
+// Rule maps a sustained error condition to degradation callbacks.
+// A Rule is degraded while more than Limit errors with Code
+// were recorded within Window.
+type Rule struct {
+	// Name identifies the rule in transition errors.
+	Name string
+	// Code of the counted errors. Empty counts all errors.
+	Code string
+	// Limit is the number of errors in the window the rule tolerates.
+	Limit int
+	// Window is how far back errors are counted. Default is one minute.
+	Window time.Duration
+	// Degrade is called with the ErrDegraded transition error
+	// when the rule becomes degraded.
+	Degrade func(error)
+	// Restore is called with the ErrRestored transition error
+	// when the rule is no longer degraded.
+	Restore func(error)
+}
+
+// Degrader evaluates Rules against the errors recorded in a Journal,
+// calling the degradation callbacks of rules that change state.
+// A Degrader is safe for concurrent use.
+//
+//	degrader := errific.NewDegrader(journal, stream.Publish, errific.Rule{
+//		Name:    "payments-fallback",
+//		Code:    "PAYMENT_001",
+//		Limit:   10,
+//		Degrade: func(error) { payments.UseFallback(true) },
+//		Restore: func(error) { payments.UseFallback(false) },
+//	})
+//
+//	go degrader.Run(ctx, 5*time.Second)
+type Degrader struct {
+	journal *Journal
+	audit   func(error)
+	rules   []Rule
+
+	mu       sync.Mutex
+	degraded []bool
+}
+
+// NewDegrader returns a Degrader of rules evaluated against journal.
+// Transition errors are also passed to audit, if not nil.
+func NewDegrader(journal *Journal, audit func(error), rules ...Rule) *Degrader {
+	return &Degrader{
+		journal:  journal,
+		audit:    audit,
+		rules:    rules,
+		degraded: make([]bool, len(rules)),
+	}
+}
+
+// Evaluate counts the recorded errors of each rule, calling its callbacks
+// and audit when the rule changes state. Evaluate returns the transition errors.
+// Callbacks are called without holding the Degrader lock, so they may
+// call Degraded.
+func (d *Degrader) Evaluate() []error {
+	transitions, callbacks := d.transition()
+
+	for i, err := range transitions {
+		if fn := callbacks[i]; fn != nil {
+			fn(err)
+		}
+		if d.audit != nil {
+			d.audit(err)
+		}
+	}
+
+	return transitions
+}
+
+// transition updates the state of each rule, returning the transition
+// errors of rules that changed state with their callbacks.
+func (d *Degrader) transition() (transitions []error, callbacks []func(error)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	records := d.journal.Records()
+
+	for i, rule := range d.rules {
+		window := rule.Window
+		if window <= 0 {
+			window = time.Minute
+		}
+
+		since := now.Add(-window)
+		count := 0
+		for _, r := range records {
+			if r.Time.Before(since) {
+				continue
+			}
+			if rule.Code == "" || r.Code == rule.Code {
+				count++
+			}
+		}
+
+		degraded := count > rule.Limit
+		if degraded == d.degraded[i] {
+			continue
+		}
+		d.degraded[i] = degraded
+
+		e, fn := ErrRestored, rule.Restore
+		if degraded {
+			e, fn = ErrDegraded, rule.Degrade
+		}
+
+		err := e.New().
+			WithContext(map[string]any{
+				ContextDegradeRule:   rule.Name,
+				ContextDegradeCode:   rule.Code,
+				ContextDegradeCount:  count,
+				ContextDegradeLimit:  rule.Limit,
+				ContextDegradeWindow: window,
+			})
+
+		transitions = append(transitions, err)
+		callbacks = append(callbacks, fn)
+	}
+
+	return transitions, callbacks
+}
+
+// Degraded reports whether the rule named name is degraded.
+func (d *Degrader) Degraded(name string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, rule := range d.rules {
+		if rule.Name == name {
+			return d.degraded[i]
+		}
+	}
+	return false
+}
+
+// Run calls Evaluate every interval until ctx is done.
+func (d *Degrader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.Evaluate()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package errific
+
+import "errors"
+
+// Depth returns the number of errors on the longest path of the err
+// chain, 1 for an error wrapping no errors and 0 for nil.
+// A suspiciously deep chain is a symptom of retry loops re-wrapping errors.
+//
+//	if errific.Depth(err) > 16 {
+//		log.Println("deep error chain", errific.WrapCount(err))
+//	}
+func Depth(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	depth := 0
+	for _, err := range children(err) {
+		if d := Depth(err); d > depth {
+			depth = d
+		}
+	}
+	return depth + 1
+}
+
+// WrapCount returns the number of errors in the err chain wrapping
+// other errors. The Err text of errific errors is not counted as wrapped.
+func WrapCount(err error) int {
+	wrapped := children(err)
+	if len(wrapped) == 0 {
+		return 0
+	}
+	count := 1
+	for _, err := range wrapped {
+		count += WrapCount(err)
+	}
+	return count
+}
+
+// children returns the errors wrapped by err. Errors of errific are its
+// joined errors, and errors wrapped by its formatted text, as errors
+// unwrapped only to satisfy errors.Is repeat the chain.
+func children(err error) []error {
+	switch x := err.(type) {
+	case nil:
+		return nil
+
+	case errific:
+		var errs []error
+		if _, ok := errText(x.err); !ok {
+			errs = append(errs, children(x.err)...)
+		}
+		for _, err := range x.errs {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errs
+
+	case interface{ Unwrap() []error }:
+		return x.Unwrap()
+
+	default:
+		if err := errors.Unwrap(err); err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+// Package echo writes errific errors of Echo handlers as responses.
+//
+// It is a separate module so errific does not depend on Echo.
+//
+//	e := echov4.New()
+//	e.HTTPErrorHandler = echo.HTTPErrorHandler
+package echo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	echov4 "github.com/labstack/echo/v4"
+	"github.com/leefernandes/errific"
+)
+
+// HTTPErrorHandler is an echo.HTTPErrorHandler writing err with
+// errific.WriteHTTP, after converting it with Convert. Responses have
+// the status of errific.MapHTTPStatus, the errific headers, Retry-After
+// for errors with a retry delay, and the body of the configured
+// errific.HTTPView. Errors without a correlation ID have that of the
+// request headers of errific.CorrelationHeaders. HEAD responses have no body.
+func HTTPErrorHandler(err error, c echov4.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	err = Convert(err)
+	errific.AddError(c.Request().Context(), err)
+
+	if errific.GetCorrelationID(err) == "" {
+		for _, h := range errific.CorrelationHeaders {
+			if id := c.Request().Header.Get(h); id != "" {
+				c.Response().Header().Set(errific.HeaderCorrelationID, id)
+				break
+			}
+		}
+	}
+
+	if c.Request().Method == http.MethodHead {
+		for k, v := range errific.EncodeHeader(err) {
+			c.Response().Header()[k] = v
+		}
+		c.NoContent(errific.MapHTTPStatus(err))
+		return
+	}
+	errific.WriteHTTP(c.Response(), err)
+}
+
+// Convert returns err, or for errors with an echo.HTTPError and no errific
+// error in their chain, an errific error with the message and status of
+// the HTTPError, wrapping its internal error.
+func Convert(err error) error {
+	if _, ok := errific.Info(err); ok {
+		return err
+	}
+
+	var he *echov4.HTTPError
+	if !errors.As(err, &he) {
+		return err
+	}
+
+	opts := []errific.ComposeOption{errific.ComposeInfo(errific.ErrorInfo{HTTPStatus: he.Code})}
+	if he.Internal != nil {
+		opts = append(opts, errific.ComposeWrapped(he.Internal))
+	}
+	return errific.Compose(fmt.Sprint(he.Message), opts...)
+}
@@ -0,0 +1,41 @@
+package echo_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	echov4 "github.com/labstack/echo/v4"
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/echo"
+)
+
+func ExampleHTTPErrorHandler() {
+	errific.Configure() // default configuration
+	var ErrNotFound errific.Err = "thing not found"
+
+	e := echov4.New()
+	e.HTTPErrorHandler = echo.HTTPErrorHandler
+	e.GET("/things/:id", func(c echov4.Context) error {
+		return ErrNotFound.New().WithCode("THING_404").WithCategory(errific.CategoryNotFound)
+	})
+	e.GET("/forbidden", func(c echov4.Context) error {
+		return echov4.NewHTTPError(http.StatusForbidden, "forbidden")
+	})
+
+	for _, path := range []string{"/things/1", "/forbidden"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-Request-Id", "req-123")
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+
+		fmt.Println(w.Code, w.Header().Get(errific.HeaderCorrelationID))
+		fmt.Print(w.Body.String())
+	}
+
+	// Output:
+	// 404 req-123
+	// {"message":"thing not found","code":"THING_404","category":"not_found"}
+	// 403 req-123
+	// {"message":"forbidden"}
+}
@@ -0,0 +1,161 @@
+// Package ecs formats errific errors as Elastic Common Schema documents,
+// so error logs are mapped in Elasticsearch and Kibana without custom
+// ingest pipelines.
+package ecs
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// Version is the ECS version of documents.
+const Version = "8.11.0"
+
+// Document is an ECS log document of an error.
+type Document struct {
+	Timestamp    time.Time         `json:"@timestamp"`
+	Message      string            `json:"message"`
+	ECS          ECS               `json:"ecs"`
+	Log          Log               `json:"log"`
+	Error        Error             `json:"error"`
+	Trace        *ID               `json:"trace,omitempty"`
+	HTTP         *HTTP             `json:"http,omitempty"`
+	Organization *ID               `json:"organization,omitempty"`
+	Service      *Service          `json:"service,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	// Errific holds errific metadata without an ECS field.
+	Errific Errific `json:"errific,omitempty"`
+}
+
+// ECS is the ecs field set.
+type ECS struct {
+	Version string `json:"version"`
+}
+
+// Log is the log field set.
+type Log struct {
+	Level  string  `json:"level"`
+	Origin *Origin `json:"origin,omitempty"`
+}
+
+// Origin is the log.origin field set of the caller.
+type Origin struct {
+	File     OriginFile `json:"file"`
+	Function string     `json:"function,omitempty"`
+}
+
+// OriginFile is the log.origin.file field set.
+type OriginFile struct {
+	Name string `json:"name"`
+	Line int    `json:"line,omitempty"`
+}
+
+// Error is the error field set.
+type Error struct {
+	Code       string `json:"code,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Message    string `json:"message"`
+	StackTrace string `json:"stack_trace,omitempty"`
+}
+
+// ID is a field set with an id, such as trace.id.
+type ID struct {
+	ID string `json:"id"`
+}
+
+// HTTP is the http field set.
+type HTTP struct {
+	Request  *ID           `json:"request,omitempty"`
+	Response *HTTPResponse `json:"response,omitempty"`
+}
+
+// HTTPResponse is the http.response field set.
+type HTTPResponse struct {
+	StatusCode int `json:"status_code"`
+}
+
+// Service is the service field set.
+type Service struct {
+	Name        string `json:"name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// Errific is the errific custom field set.
+type Errific struct {
+	Context    map[string]any `json:"context,omitempty"`
+	Retryable  bool           `json:"retryable,omitempty"`
+	RetryAfter string         `json:"retry_after,omitempty"`
+}
+
+// NewDocument returns the ECS Document of err.
+//
+// error.type is the errific Category, trace.id the correlation ID,
+// http.request.id the request ID, and organization.id the tenant.
+// Context and retry metadata, which have no ECS fields, are under errific.
+//
+//	json.NewEncoder(os.Stdout).Encode(ecs.NewDocument(err))
+func NewDocument(err error) Document {
+	info := errific.ResolveChain(err)
+	doc := Document{
+		Timestamp: time.Now().UTC(),
+		Message:   info.Message,
+		ECS:       ECS{Version: Version},
+		Log:       Log{Level: strings.ToLower(errific.LogLevel(err).String())},
+		Error: Error{
+			Code:       info.Code,
+			Type:       string(info.Category),
+			Message:    err.Error(),
+			StackTrace: strings.TrimPrefix(errific.GetStack(err), "\n"),
+		},
+		Labels: info.Labels,
+		Errific: Errific{
+			Context:   info.Context,
+			Retryable: info.Retryable,
+		},
+	}
+
+	if info.Caller != "" {
+		doc.Log.Origin = origin(info.Caller)
+	}
+	if info.CorrelationID != "" {
+		doc.Trace = &ID{ID: info.CorrelationID}
+	}
+	if info.RequestID != "" || info.HTTPStatus != 0 {
+		doc.HTTP = &HTTP{}
+		if info.RequestID != "" {
+			doc.HTTP.Request = &ID{ID: info.RequestID}
+		}
+		if info.HTTPStatus != 0 {
+			doc.HTTP.Response = &HTTPResponse{StatusCode: info.HTTPStatus}
+		}
+	}
+	if info.Tenant != "" {
+		doc.Organization = &ID{ID: info.Tenant}
+	}
+	if s := info.Service; s != nil {
+		doc.Service = &Service{Name: s.Name, Version: s.Version, Environment: s.Env}
+	}
+	if info.RetryAfter != 0 {
+		doc.Errific.RetryAfter = info.RetryAfter.String()
+	}
+
+	return doc
+}
+
+// origin parses a caller formatted as file:line.function.
+func origin(caller string) *Origin {
+	i := strings.LastIndex(caller, ":")
+	if i < 0 {
+		return &Origin{File: OriginFile{Name: caller}}
+	}
+
+	o := &Origin{File: OriginFile{Name: caller[:i]}}
+	line, function, _ := strings.Cut(caller[i+1:], ".")
+	o.File.Line, _ = strconv.Atoi(line)
+	o.Function = function
+	return o
+}
@@ -0,0 +1,37 @@
+package ecs_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/ecs"
+)
+
+func ExampleNewDocument() {
+	errific.Configure() // default configuration
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithCorrelationID("abc").
+		WithTenant("acme").
+		WithLabels(map[string]string{"region": "us-east-1"})
+
+	doc := ecs.NewDocument(err)
+	fmt.Println(doc.Log.Level, doc.Log.Origin.File.Line, doc.Log.Origin.Function)
+
+	doc.Log.Origin, doc.Error.Message = nil, ""
+	b, _ := json.Marshal(struct {
+		Error        ecs.Error         `json:"error"`
+		Trace        *ecs.ID           `json:"trace"`
+		Organization *ecs.ID           `json:"organization"`
+		Labels       map[string]string `json:"labels"`
+	}{doc.Error, doc.Trace, doc.Organization, doc.Labels})
+	fmt.Println(string(b))
+
+	// Output:
+	// error 15 ExampleNewDocument
+	// {"error":{"code":"QUERY_001","type":"server","message":""},"trace":{"id":"abc"},"organization":{"id":"acme"},"labels":{"region":"us-east-1"}}
+}
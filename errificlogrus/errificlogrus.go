@@ -0,0 +1,93 @@
+// Package errificlogrus logs errific errors with github.com/sirupsen/logrus.
+//
+// Fields returns the metadata of an error as logrus fields, and Hook
+// adds them to every entry logged WithError.
+//
+//	logrus.WithFields(errificlogrus.Fields(err)).Error("error handling request")
+//
+//	logrus.AddHook(errificlogrus.Hook{})
+//	logrus.WithError(err).Error("error handling request")
+package errificlogrus
+
+import (
+	"github.com/leefernandes/errific"
+	"github.com/sirupsen/logrus"
+)
+
+// Fields returns the message, caller, metadata, stack, and the messages
+// of the wrapped error chain of err as logrus fields. Unset fields are
+// omitted.
+func Fields(err error) logrus.Fields {
+	if err == nil {
+		return logrus.Fields{}
+	}
+
+	info := errific.ResolveChain(err)
+	fields := logrus.Fields{logrus.ErrorKey: err.Error()}
+	str := func(key, value string) {
+		if value != "" {
+			fields[key] = value
+		}
+	}
+
+	str("message", info.Message)
+	str("caller", info.Caller)
+	str("code", info.Code)
+	str("category", string(info.Category))
+	str("correlation_id", info.CorrelationID)
+	str("request_id", info.RequestID)
+	str("tenant", info.Tenant)
+	if info.HTTPStatus != 0 {
+		fields["http_status"] = info.HTTPStatus
+	}
+	if info.Retryable {
+		fields["retryable"] = true
+	}
+	if info.RetryAfter != 0 {
+		fields["retry_after"] = info.RetryAfter.String()
+	}
+	if len(info.Labels) > 0 {
+		fields["labels"] = info.Labels
+	}
+	if len(info.Context) > 0 {
+		fields["context"] = info.Context
+	}
+	if info.Upstream != nil {
+		fields["upstream"] = info.Upstream
+	}
+	if info.Service != nil {
+		fields["service"] = info.Service
+	}
+	str("stack", errific.GetStack(err))
+
+	if chain := errific.Chain(err); len(chain) > 1 {
+		messages := make([]string, 0, len(chain))
+		for _, level := range chain {
+			messages = append(messages, level.Message)
+		}
+		fields["chain"] = messages
+	}
+
+	return fields
+}
+
+// Hook is a logrus.Hook adding the Fields of the error of entries
+// logged WithError. Entries without an error are not changed.
+type Hook struct{}
+
+// Levels returns all levels.
+func (Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire adds the Fields of the entry error to entry.
+func (Hook) Fire(entry *logrus.Entry) error {
+	err, ok := entry.Data[logrus.ErrorKey].(error)
+	if !ok {
+		return nil
+	}
+	for k, v := range Fields(err) {
+		entry.Data[k] = v
+	}
+	return nil
+}
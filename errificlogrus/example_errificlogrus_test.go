@@ -0,0 +1,29 @@
+package errificlogrus_test
+
+import (
+	"os"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/errificlogrus"
+	"github.com/sirupsen/logrus"
+)
+
+func ExampleHook() {
+	errific.Configure(errific.NoCapture)
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	logger := logrus.New()
+	logger.SetOutput(os.Stdout)
+	logger.SetFormatter(&logrus.JSONFormatter{DisableTimestamp: true})
+	logger.AddHook(errificlogrus.Hook{})
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithCorrelationID("abc")
+	logger.WithError(err).Error("error handling request")
+
+	// Output:
+	// {"category":"server","code":"QUERY_001","correlation_id":"abc","error":"error querying thing","level":"error","message":"error querying thing","msg":"error handling request"}
+}
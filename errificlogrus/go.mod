@@ -0,0 +1,12 @@
+module github.com/leefernandes/errific/errificlogrus
+
+go 1.23
+
+replace github.com/leefernandes/errific => ../
+
+require (
+	github.com/leefernandes/errific v0.0.0-00010101000000-000000000000
+	github.com/sirupsen/logrus v1.10.2
+)
+
+require golang.org/x/sys v0.13.0 // indirect
@@ -0,0 +1,94 @@
+// Package errificslog logs errific errors with log/slog.
+//
+// Attrs expands the metadata of an error into slog attributes, and
+// Handler expands error attributes of any record logged through it,
+// so errors logged with the standard library logger keep their structure.
+//
+//	logger := slog.New(errificslog.NewHandler(slog.NewJSONHandler(os.Stderr, nil)))
+//	logger.Error("error handling request", "err", err)
+package errificslog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// Attrs returns the message and metadata of err as slog attributes,
+// with labels, context, upstream, and service as groups, like the
+// LogValue of errific errors. The message is the Error() of err, including
+// wrapping errors. Errors without an errific error in their chain only
+// have the message. Unset fields are omitted.
+//
+//	logger.LogAttrs(ctx, errific.LogLevel(err), "error handling request",
+//		slog.Any("err", slog.GroupValue(errificslog.Attrs(err)...)))
+func Attrs(err error) []slog.Attr {
+	if err == nil {
+		return nil
+	}
+
+	var valuer slog.LogValuer
+	if !errors.As(err, &valuer) {
+		return []slog.Attr{slog.String("message", err.Error())}
+	}
+
+	attrs := valuer.LogValue().Group()
+	for i, a := range attrs {
+		if a.Key == "message" {
+			attrs[i] = slog.String("message", err.Error())
+		}
+	}
+	return attrs
+}
+
+// Handler is a slog.Handler expanding attributes with error values
+// into groups of their Attrs before passing records to the wrapped handler.
+type Handler struct {
+	handler slog.Handler
+}
+
+// NewHandler returns a Handler wrapping h.
+func NewHandler(h slog.Handler) *Handler {
+	return &Handler{handler: h}
+}
+
+// Enabled reports whether the wrapped handler handles records at level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle expands the error attributes of r and passes it to the wrapped handler.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	expanded := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		expanded.AddAttrs(expand(a))
+		return true
+	})
+	return h.handler.Handle(ctx, expanded)
+}
+
+// WithAttrs returns a Handler with the expanded attrs.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	expanded := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		expanded[i] = expand(a)
+	}
+	return &Handler{handler: h.handler.WithAttrs(expanded)}
+}
+
+// WithGroup returns a Handler with the group name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{handler: h.handler.WithGroup(name)}
+}
+
+// expand returns a as a group of Attrs if its value is an error.
+func expand(a slog.Attr) slog.Attr {
+	if k := a.Value.Kind(); k != slog.KindAny && k != slog.KindLogValuer {
+		return a
+	}
+	err, ok := a.Value.Any().(error)
+	if !ok {
+		return a
+	}
+	return slog.Attr{Key: a.Key, Value: slog.GroupValue(Attrs(err)...)}
+}
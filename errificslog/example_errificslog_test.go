@@ -0,0 +1,34 @@
+package errificslog_test
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/errificslog"
+)
+
+func ExampleNewHandler() {
+	errific.Configure(errific.Disabled)
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	h := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == "caller" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := slog.New(errificslog.NewHandler(h))
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithLabels(map[string]string{"region": "us-east-1"})
+	logger.Error("error handling request", "err", err)
+
+	// Output:
+	// {"level":"ERROR","msg":"error handling request","err":{"message":"error querying thing","code":"QUERY_001","category":"server","labels":{"region":"us-east-1"}}}
+}
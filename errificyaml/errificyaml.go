@@ -0,0 +1,35 @@
+// Package errificyaml loads errific Mappings from YAML with gopkg.in/yaml.v3.
+//
+// It is a separate module so errific does not depend on a YAML decoder.
+//
+//	m, err := errificyaml.LoadMappings("errors.yaml")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	errific.Configure(errific.UseMappings(m))
+package errificyaml
+
+import (
+	"os"
+
+	"github.com/leefernandes/errific"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadMappings reads YAML Mappings from the file at path.
+//
+//	http:
+//	  validation: 422
+//	grpc:
+//	  validation: FAILED_PRECONDITION
+//	mcp:
+//	  THING_001: -32602
+func LoadMappings(path string) (errific.Mappings, error) {
+	var m errific.Mappings
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+	err = yaml.Unmarshal(b, &m)
+	return m, err
+}
@@ -0,0 +1,29 @@
+package errificyaml_test
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/errificyaml"
+)
+
+func ExampleLoadMappings() {
+	m, err := errificyaml.LoadMappings("testdata/mappings.yaml")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	errific.Configure(errific.UseMappings(m))
+	defer errific.Configure()
+	var ErrValidateThing errific.Err = "error validating thing"
+
+	err = ErrValidateThing.New().WithCode("THING_001").WithCategory(errific.CategoryValidation)
+	fmt.Println(errific.MapHTTPStatus(err), errific.MapGRPCCode(err), errific.MapMCPCode(err))
+
+	err = ErrValidateThing.New().WithCategory(errific.CategoryNotFound)
+	fmt.Println(errific.MapHTTPStatus(err), errific.MapGRPCCode(err), errific.MapMCPCode(err))
+
+	// Output:
+	// 422 FAILED_PRECONDITION -32602
+	// 404 NOT_FOUND -32603
+}
@@ -0,0 +1,10 @@
+module github.com/leefernandes/errific/errificyaml
+
+go 1.23
+
+replace github.com/leefernandes/errific => ../
+
+require (
+	github.com/leefernandes/errific v0.0.0-00010101000000-000000000000
+	gopkg.in/yaml.v3 v3.0.1
+)
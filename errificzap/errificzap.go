@@ -0,0 +1,128 @@
+// Package errificzap logs errific errors with go.uber.org/zap.
+//
+// Error returns a field encoding the message and metadata of an error as
+// a structured object, and Fields returns them as top level fields.
+//
+//	logger.Error("error handling request", errificzap.Error(err))
+package errificzap
+
+import (
+	"sort"
+	"time"
+
+	"github.com/leefernandes/errific"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Object is a zapcore.ObjectMarshaler of an error and its metadata.
+type Object struct {
+	err error
+}
+
+// Error returns a field with key "error" encoding err as an Object.
+// Nil errors are skipped like zap.Error.
+func Error(err error) zap.Field {
+	return NamedError("error", err)
+}
+
+// NamedError returns a field with key encoding err as an Object.
+func NamedError(key string, err error) zap.Field {
+	if err == nil {
+		return zap.Skip()
+	}
+	return zap.Object(key, Object{err: err})
+}
+
+// MarshalLogObject encodes the message, caller, metadata, and stack of
+// the error. Unset fields are omitted.
+func (o Object) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, f := range Fields(o.err) {
+		f.AddTo(enc)
+	}
+	return nil
+}
+
+// Fields returns the message, caller, metadata, and stack of err as zap
+// fields, with labels, context, upstream, and service as objects.
+//
+//	logger.Error("error handling request", errificzap.Fields(err)...)
+func Fields(err error) []zap.Field {
+	if err == nil {
+		return nil
+	}
+
+	info := errific.ResolveChain(err)
+	fields := []zap.Field{zap.String("message", info.Message)}
+	str := func(key, value string) {
+		if value != "" {
+			fields = append(fields, zap.String(key, value))
+		}
+	}
+
+	str("caller", info.Caller)
+	str("code", info.Code)
+	str("category", string(info.Category))
+	str("correlation_id", info.CorrelationID)
+	str("request_id", info.RequestID)
+	str("tenant", info.Tenant)
+	if info.HTTPStatus != 0 {
+		fields = append(fields, zap.Int("http_status", info.HTTPStatus))
+	}
+	if info.Retryable {
+		fields = append(fields, zap.Bool("retryable", true))
+	}
+	if info.RetryAfter != 0 {
+		fields = append(fields, zap.Duration("retry_after", time.Duration(info.RetryAfter)))
+	}
+
+	if len(info.Labels) > 0 {
+		labels := info.Labels
+		fields = append(fields, zap.Object("labels", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+			for _, k := range sortedKeys(labels) {
+				enc.AddString(k, labels[k])
+			}
+			return nil
+		})))
+	}
+	if len(info.Context) > 0 {
+		context := info.Context
+		fields = append(fields, zap.Object("context", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+			for _, k := range sortedKeys(context) {
+				zap.Any(k, context[k]).AddTo(enc)
+			}
+			return nil
+		})))
+	}
+	if u := info.Upstream; u != nil {
+		fields = append(fields, zap.Object("upstream", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+			enc.AddString("service", u.Service)
+			enc.AddString("code", u.Code)
+			enc.AddInt("status", u.Status)
+			return nil
+		})))
+	}
+	if s := info.Service; s != nil {
+		fields = append(fields, zap.Object("service", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+			enc.AddString("name", s.Name)
+			enc.AddString("version", s.Version)
+			enc.AddString("env", s.Env)
+			return nil
+		})))
+	}
+
+	if stack := errific.GetStack(err); stack != "" {
+		fields = append(fields, zap.String("stack", stack))
+	}
+
+	return fields
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,29 @@
+package errificzap_test
+
+import (
+	"os"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/errificzap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func ExampleError() {
+	errific.Configure(errific.NoCapture)
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	encoder := zapcore.NewJSONEncoder(zapcore.EncoderConfig{MessageKey: "msg"})
+	logger := zap.New(zapcore.NewCore(encoder, zapcore.AddSync(os.Stdout), zap.DebugLevel))
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithCorrelationID("abc").
+		WithLabels(map[string]string{"region": "us-east-1"})
+	logger.Error("error handling request", errificzap.Error(err))
+
+	// Output:
+	// {"msg":"error handling request","error":{"message":"error querying thing","code":"QUERY_001","category":"server","correlation_id":"abc","labels":{"region":"us-east-1"}}}
+}
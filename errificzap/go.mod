@@ -0,0 +1,12 @@
+module github.com/leefernandes/errific/errificzap
+
+go 1.23
+
+replace github.com/leefernandes/errific => ../
+
+require (
+	github.com/leefernandes/errific v0.0.0-00010101000000-000000000000
+	go.uber.org/zap v1.27.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
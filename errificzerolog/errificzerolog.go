@@ -0,0 +1,104 @@
+// Package errificzerolog logs errific errors with github.com/rs/zerolog.
+//
+// Object and Dict encode the message and metadata of an error as a nested
+// JSON object instead of the flat Error() string.
+//
+//	log.Error().Object("error", errificzerolog.Object(err)).Msg("error handling request")
+//	log.Error().Dict("error", errificzerolog.Dict(err)).Msg("error handling request")
+package errificzerolog
+
+import (
+	"sort"
+	"time"
+
+	"github.com/leefernandes/errific"
+	"github.com/rs/zerolog"
+)
+
+// Marshaler is a zerolog.LogObjectMarshaler of an error and its metadata.
+type Marshaler struct {
+	err error
+}
+
+// Object returns the Marshaler of err.
+func Object(err error) Marshaler {
+	return Marshaler{err: err}
+}
+
+// Dict returns a zerolog dictionary of the message and metadata of err.
+func Dict(err error) *zerolog.Event {
+	dict := zerolog.Dict()
+	Object(err).MarshalZerologObject(dict)
+	return dict
+}
+
+// MarshalZerologObject encodes the message, caller, metadata, and stack of
+// the error, with labels, context, upstream, and service as nested objects.
+// Unset fields are omitted.
+func (m Marshaler) MarshalZerologObject(e *zerolog.Event) {
+	if m.err == nil {
+		return
+	}
+
+	info := errific.ResolveChain(m.err)
+	e.Str("message", info.Message)
+	str := func(key, value string) {
+		if value != "" {
+			e.Str(key, value)
+		}
+	}
+
+	str("caller", info.Caller)
+	str("code", info.Code)
+	str("category", string(info.Category))
+	str("correlation_id", info.CorrelationID)
+	str("request_id", info.RequestID)
+	str("tenant", info.Tenant)
+	if info.HTTPStatus != 0 {
+		e.Int("http_status", info.HTTPStatus)
+	}
+	if info.Retryable {
+		e.Bool("retryable", true)
+	}
+	if info.RetryAfter != 0 {
+		e.Dur("retry_after", time.Duration(info.RetryAfter))
+	}
+
+	if len(info.Labels) > 0 {
+		labels := zerolog.Dict()
+		for _, k := range sortedKeys(info.Labels) {
+			labels.Str(k, info.Labels[k])
+		}
+		e.Dict("labels", labels)
+	}
+	if len(info.Context) > 0 {
+		context := zerolog.Dict()
+		for _, k := range sortedKeys(info.Context) {
+			context.Interface(k, info.Context[k])
+		}
+		e.Dict("context", context)
+	}
+	if u := info.Upstream; u != nil {
+		e.Dict("upstream", zerolog.Dict().
+			Str("service", u.Service).
+			Str("code", u.Code).
+			Int("status", u.Status))
+	}
+	if s := info.Service; s != nil {
+		e.Dict("service", zerolog.Dict().
+			Str("name", s.Name).
+			Str("version", s.Version).
+			Str("env", s.Env))
+	}
+
+	str("stack", errific.GetStack(m.err))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
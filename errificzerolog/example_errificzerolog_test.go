@@ -0,0 +1,29 @@
+package errificzerolog_test
+
+import (
+	"os"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/errificzerolog"
+	"github.com/rs/zerolog"
+)
+
+func ExampleObject() {
+	errific.Configure(errific.NoCapture)
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	logger := zerolog.New(os.Stdout)
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithCorrelationID("abc").
+		WithContext(map[string]any{"table": "things"})
+	logger.Error().Object("error", errificzerolog.Object(err)).Msg("error handling request")
+	logger.Error().Dict("error", errificzerolog.Dict(err)).Msg("error handling request")
+
+	// Output:
+	// {"level":"error","error":{"message":"error querying thing","code":"QUERY_001","category":"server","correlation_id":"abc","context":{"table":"things"}},"message":"error handling request"}
+	// {"level":"error","error":{"message":"error querying thing","code":"QUERY_001","category":"server","correlation_id":"abc","context":{"table":"things"}},"message":"error handling request"}
+}
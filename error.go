@@ -1,10 +1,14 @@
 package errific
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"runtime"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 )
 
 // Err string type.
@@ -24,18 +28,102 @@ type Err string
 //
 //	return ErrProcessThing.New(err)
 func (e Err) New(errs ...error) errific {
+	if c.reportCreated {
+		return Profiled{Err: e}.newReportedAt(1, errs)
+	}
+	return e.newAt(1, errs...)
+}
+
+// Context keys set by NewCtx.
+const (
+	ContextDeadlineRemainingMS = "deadline_remaining_ms"
+	ContextDone                = "ctx_done"
+)
+
+// NewCtx returns an error like New, recording in its context whether ctx
+// was already done and, if ctx has a deadline, the milliseconds remaining
+// until it, negative once passed. The cancellation cause of ctx is
+// recorded with WithCancelCause.
+//
+//	return ErrProcessThing.NewCtx(ctx, err)
+func (e Err) NewCtx(ctx context.Context, errs ...error) errific {
+	if c.reportCreated {
+		return Profiled{Err: e}.newCtxReportedAt(1, ctx, errs)
+	}
+	return Profiled{Err: e}.newCtxAt(1, ctx, errs...)
+}
+
+// NewAt returns an error like New with the caller skip frames above
+// the caller of NewAt, for helpers creating errors for their callers.
+// NewAt(0) is New.
+//
+//	func queryErr(err error) error {
+//		return ErrQuery.NewAt(1, err)
+//	}
+func (e Err) NewAt(skip int, errs ...error) errific {
+	if c.reportCreated {
+		return Profiled{Err: e}.newReportedAt(skip+1, errs)
+	}
+	return e.newAt(skip+1, errs...)
+}
+
+// newAt returns an error like New with the caller skip frames above
+// the function calling newAt, for helpers creating errors for their callers.
+func (e Err) newAt(skip int, errs ...error) errific {
+	return Profiled{Err: e}.newAt(skip+1, errs...)
+}
+
+// newCtxAt returns an error like NewCtx with the caller skip frames above
+// the function calling newCtxAt.
+func (p Profiled) newCtxAt(skip int, ctx context.Context, errs ...error) errific {
+	fields := map[string]any{ContextDone: ctx.Err() != nil}
+	if deadline, ok := ctx.Deadline(); ok {
+		fields[ContextDeadlineRemainingMS] = time.Until(deadline).Milliseconds()
+	}
+	return p.newAt(skip+1, errs...).WithContext(fields).WithCancelCause(ctx)
+}
+
+// newReportedAt returns an error like newAt of a copy of errs, dispatched
+// to the Reporters for ReportCreated. Only the copy of errs is moved to
+// the heap by reporting, so errors are not allocated for ReportCreated
+// when it is not configured.
+func (p Profiled) newReportedAt(skip int, errs []error) errific {
+	return created(p.newAt(skip+1, slices.Clone(errs)...))
+}
+
+// newCtxReportedAt returns an error like newCtxAt of a copy of errs,
+// dispatched to the Reporters for ReportCreated, as newReportedAt.
+func (p Profiled) newCtxReportedAt(skip int, ctx context.Context, errs []error) errific {
+	return created(p.newCtxAt(skip+1, ctx, slices.Clone(errs)...))
+}
+
+// newAt returns an error like New with the caller skip frames above
+// the function calling newAt.
+func (p Profiled) newAt(skip int, errs ...error) errific {
+	errs = normalize(errs)
 	a := make([]any, len(errs))
 	for i := range errs {
 		a[i] = errs[i]
 	}
 
-	caller, stack := callstack(a)
-	return errific{
-		err:    e,
-		errs:   errs,
-		caller: caller,
-		stack:  stack,
+	caller, pc, stack := callstackAt(skip, p.profile.captureStack(), a)
+	err := inherit(errific{
+		err:     p.id(),
+		errs:    errs,
+		caller:  caller,
+		pc:      pc,
+		stack:   stack,
+		profile: p.profile,
+	}, a)
+
+	if c.originExpr && len(errs) > 0 {
+		if _, file, line, ok := runtime.Caller(skip + 1); ok {
+			if expr := originExpr(file, line); expr != "" {
+				err = err.WithContext(map[string]any{ContextOriginExpr: expr})
+			}
+		}
 	}
+	return err
 }
 
 // Errorf returns an error using Err formatted as text.
@@ -45,13 +133,21 @@ func (e Err) New(errs ...error) errific {
 //
 //	return ErrProcessThing.Errorf("abc")
 func (e Err) Errorf(a ...any) errific {
-	caller, stack := callstack(a)
-	return errific{
-		err:    fmt.Errorf(e.Error(), a...),
-		caller: caller,
-		unwrap: []error{e},
-		stack:  stack,
-	}
+	return created(Profiled{Err: e}.errorfAt(1, a...))
+}
+
+// errorfAt returns an error like Errorf with the caller skip frames above
+// the function calling errorfAt.
+func (p Profiled) errorfAt(skip int, a ...any) errific {
+	caller, pc, stack := callstackAt(skip, p.profile.captureStack(), a)
+	return inherit(errific{
+		err:     fmt.Errorf(p.Error(), a...),
+		caller:  caller,
+		pc:      pc,
+		unwrap:  []error{p.id()},
+		stack:   stack,
+		profile: p.profile,
+	}, a)
 }
 
 // Withf returns an error with a formatted string inline to Err as text.
@@ -60,14 +156,22 @@ func (e Err) Errorf(a ...any) errific {
 //
 //	return ErrProcessThing.Withf("id: '%s'", "abc")
 func (e Err) Withf(format string, a ...any) errific {
-	caller, stack := callstack(a)
-	format = e.Error() + ": " + format
-	return errific{
-		err:    fmt.Errorf(format, a...),
-		caller: caller,
-		unwrap: []error{e},
-		stack:  stack,
-	}
+	return created(Profiled{Err: e}.withfAt(1, format, a...))
+}
+
+// withfAt returns an error like Withf with the caller skip frames above
+// the function calling withfAt.
+func (p Profiled) withfAt(skip int, format string, a ...any) errific {
+	caller, pc, stack := callstackAt(skip, p.profile.captureStack(), a)
+	format = p.Error() + ": " + format
+	return inherit(errific{
+		err:     fmt.Errorf(format, a...),
+		caller:  caller,
+		pc:      pc,
+		unwrap:  []error{p.id()},
+		stack:   stack,
+		profile: p.profile,
+	}, a)
 }
 
 // Wrapf return an error using Err as text and wraps a formatted error.
@@ -77,39 +181,116 @@ func (e Err) Withf(format string, a ...any) errific {
 //
 //	return ErrProcessThing.Wrapf("cause: %w", err)
 func (e Err) Wrapf(format string, a ...any) errific {
-	caller, stack := callstack(a)
-	return errific{
-		err:    e,
-		errs:   []error{fmt.Errorf(format, a...)},
-		caller: caller,
-		stack:  stack,
-	}
+	return created(Profiled{Err: e}.wrapfAt(1, format, a...))
+}
+
+// wrapfAt returns an error like Wrapf with the caller skip frames above
+// the function calling wrapfAt.
+func (p Profiled) wrapfAt(skip int, format string, a ...any) errific {
+	caller, pc, stack := callstackAt(skip, p.profile.captureStack(), a)
+	return inherit(errific{
+		err:     p.id(),
+		errs:    []error{fmt.Errorf(format, a...)},
+		caller:  caller,
+		pc:      pc,
+		stack:   stack,
+		profile: p.profile,
+	}, a)
 }
 
 func (e Err) Error() string {
 	return string(e)
 }
 
+// ErrNilWrapped replaces nil errors passed to New and Join
+// when FlagNilErrors is configured.
+var ErrNilWrapped Err = "nil error wrapped"
+
+// normalize drops nil errors from errs, or replaces them
+// with ErrNilWrapped when FlagNilErrors is configured.
+func normalize(errs []error) []error {
+	nils := 0
+	for _, err := range errs {
+		if err == nil {
+			nils++
+		}
+	}
+	if nils == 0 {
+		return errs
+	}
+
+	normalized := make([]error, 0, len(errs)-nils)
+	for i, err := range errs {
+		switch {
+		case err != nil:
+			normalized = append(normalized, err)
+		case bool(c.flagNilErrors):
+			normalized = append(normalized, fmt.Errorf("%w: argument %d", ErrNilWrapped, i))
+		}
+	}
+	return normalized
+}
+
 type errific struct {
 	err    error   // primary error.
 	errs   []error // errors used in string output, and satisfy errors.Is.
 	unwrap []error // errors not used in string output, but satisfy errors.Is.
 	caller string  // caller information.
+	pc     uintptr // program counter of the caller, for Fingerprint.
 	stack  []byte  // optional stack buffer.
+
+	code           string            // machine readable code.
+	category       Category          // error classification.
+	correlationID  string            // cross-service correlation ID.
+	requestID      string            // request ID.
+	tenant         string            // tenant the error occurred for.
+	labels         map[string]string // low cardinality key/value labels.
+	context        map[string]any    // diagnostic key/values.
+	upstream       *Upstream         // dependency error identity.
+	service        *Service          // origin service identity.
+	classification *Classification   // how the category was determined.
+	deprecation    *Deprecation      // deprecation of the code.
+	httpStatus     int               // HTTP status code.
+	retryable      bool              // whether the operation may be retried.
+	retryAfter     time.Duration     // delay before retrying.
+	logRef         *LogRef           // location of the raw log line.
+	cancelCause    error             // context.Cause of a cancelled context.
+	mismatch       *Mismatch         // expected and actual value of a field.
+	fields         map[string]any    // values of custom fields.
+	docs           []Doc             // documentation references.
+
+	profile *profile // formatting options bound with WithOptions.
 }
 
-func (e errific) Error() (msg string) {
-	switch c.caller {
-	case Disabled:
+func (e errific) Error() string {
+	msg := e.render()
+	if c.shadow != nil {
+		shadow(e, msg)
+	}
+	return msg
+}
 
-	case Prefix:
+// render returns the Error() output of e.
+func (e errific) render() (msg string) {
+	caller, layout, withStack := c.caller, c.layout, c.withStack
+	if p := e.profile; p != nil {
+		caller, layout, withStack = p.caller, p.layout, p.withStack
+	} else if msg, ok := format(e); ok {
+		return msg
+	}
+
+	switch {
+	case caller == Disabled || e.caller == "":
+		msg = e.err.Error()
+
+	case caller == Prefix:
 		msg = fmt.Sprintf("[%s] %s", e.caller, e.err.Error())
 
 	default:
 		msg = fmt.Sprintf("%s [%s]", e.err.Error(), e.caller)
 	}
 
-	switch c.layout {
+	switch layout {
 	case Inline:
 		for i := range e.errs {
 			msg = fmt.Sprintf("%s ↩ %s", msg, e.errs[i].Error())
@@ -122,16 +303,29 @@ func (e errific) Error() (msg string) {
 	}
 
 	// TODO prevent duplicate stacking of the stacks.
-	if c.withStack && len(e.stack) > 0 {
-		msg = strings.ReplaceAll(msg, string(e.stack), "")
+	if withStack && len(e.stack) > 0 {
+		// remove the stacks of wrapped errors from their messages,
+		// longest first as stacks may end with the same frames.
+		stacks := strings.Split(string(e.stack), stackSeparator)
+		for i := range e.errs {
+			stacks = append(stacks, string(unwrapStack([]any{e.errs[i]})))
+		}
+		sort.Slice(stacks, func(i, j int) bool {
+			return len(stacks[i]) > len(stacks[j])
+		})
+		for _, stack := range stacks {
+			if stack != "" {
+				msg = strings.ReplaceAll(msg, stack, "")
+			}
+		}
 		msg += string(e.stack)
 	}
 
-	return msg
+	return mask(msg)
 }
 
 func (e errific) Join(errs ...error) error {
-	e.errs = append(e.errs, errs...)
+	e.errs = append(e.errs, normalize(errs)...)
 	return e
 }
 
@@ -173,54 +367,81 @@ func unwrapStack(errs []any) []byte {
 	return nil
 }
 
-func callstack(errs []any) (caller string, stack []byte) {
+// callstackAt captures the caller skip frames above
+// the function calling callstackAt, and the stack if withStack.
+func callstackAt(skip int, withStack bool, errs []any) (caller string, callerPC uintptr, stack []byte) {
+	if c.noCapture {
+		return "", 0, stack
+	}
+
 	pc := make([]uintptr, 32)
-	n := runtime.Callers(3, pc)
+	n := runtime.Callers(3+skip, pc)
 	if n == 0 {
-		return "", stack
+		return "", 0, stack
 	}
 
 	frames := runtime.CallersFrames(pc)
 	frame, more := frames.Next()
-	caller = parseFrame(frame)
+	caller, callerPC = parseFrame(frame), pc[0]
 
-	if !c.withStack {
-		return caller, stack
+	if c.countCallSites {
+		countCallSite(caller)
 	}
 
-	stack = unwrapStack(errs)
+	if !withStack {
+		return caller, callerPC, stack
+	}
 
-	if len(stack) > 0 {
-		return caller, stack
+	var inherited []byte
+	if c.stackMode != CaptureNewStack {
+		inherited = unwrapStack(errs)
 	}
 
-	if !more {
-		return caller, stack
+	if c.stackMode == InheritStack && len(inherited) > 0 {
+		return caller, callerPC, inherited
 	}
 
-	for {
-		frame, more := frames.Next()
-		if !strings.HasPrefix(frame.File, runtime.GOROOT()) {
-			caller := fmt.Sprintf("\n  %s", parseFrame(frame))
-			stack = append(stack, caller...)
+	for more {
+		var frame runtime.Frame
+		frame, more = frames.Next()
+		if goroot != "" && strings.HasPrefix(frame.File, goroot) {
+			continue
+		}
+		if c.pcStack {
+			stack = append(stack, pcFrame(frame.PC)...)
+			continue
 		}
-		if !more {
-			break
+		caller := fmt.Sprintf("\n  %s", parseFrame(frame))
+		stack = append(stack, caller...)
+	}
+
+	if len(inherited) > 0 {
+		if len(stack) > 0 {
+			stack = append(stack, stackSeparator...)
 		}
+		stack = append(stack, inherited...)
 	}
 
-	return caller, stack
+	return caller, callerPC, stack
 }
 
 func parseFrame(frame runtime.Frame) string {
-	funcParts := strings.Split(frame.Function, "/")
-	funcParts = strings.Split(funcParts[len(funcParts)-1], ".")
-	callFunc := funcParts[len(funcParts)-1]
+	callFunc := frame.Function
+	if c.funcFormat != FullFunc {
+		funcParts := strings.Split(callFunc, "/")
+		callFunc = funcParts[len(funcParts)-1]
+	}
+	if c.funcFormat == ShortFunc {
+		funcParts := strings.Split(callFunc, ".")
+		callFunc = funcParts[len(funcParts)-1]
+	}
 	callFile := frame.File
 	for _, trimPrefix := range c.trimPrefixes {
 		callFile = strings.TrimPrefix(callFile, trimPrefix)
 	}
-	callFile = strings.TrimPrefix(callFile, runtime.GOROOT())
+	if goroot != "" {
+		callFile = strings.TrimPrefix(callFile, goroot)
+	}
 	callFile = strings.TrimPrefix(callFile, root)
 	callLine := frame.Line
 
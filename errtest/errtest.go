@@ -0,0 +1,26 @@
+// Package errtest isolates errific configuration in tests.
+package errtest
+
+import (
+	"testing"
+
+	"github.com/leefernandes/errific"
+)
+
+// ConfigureScoped configures errific with opts for the test or benchmark
+// tb, restoring the previous configuration when tb and its subtests
+// complete, so configuration does not leak into later tests.
+//
+// Configuration is global, so tests configuring errific differently
+// must not run in parallel with each other.
+//
+//	func TestThing(t *testing.T) {
+//		errtest.ConfigureScoped(t, errific.WithStack)
+//		...
+//	}
+func ConfigureScoped(tb testing.TB, opts ...errific.Option) {
+	tb.Helper()
+	snapshot := errific.CurrentConfig()
+	tb.Cleanup(snapshot.Restore)
+	errific.Configure(opts...)
+}
@@ -0,0 +1,27 @@
+package errtest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/errtest"
+)
+
+var ErrQuery errific.Err = "error querying thing"
+
+func TestConfigureScoped(t *testing.T) {
+	errific.Configure(errific.Disabled)
+	defer errific.Configure()
+
+	t.Run("scoped", func(t *testing.T) {
+		errtest.ConfigureScoped(t, errific.Prefix)
+		if msg := ErrQuery.New().Error(); !strings.HasPrefix(msg, "[") {
+			t.Errorf("want caller prefix, got %q", msg)
+		}
+	})
+
+	if msg := ErrQuery.New().Error(); msg != string(ErrQuery) {
+		t.Errorf("want configuration restored, got %q", msg)
+	}
+}
@@ -0,0 +1,78 @@
+package errific_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleArchiver() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	objects := map[string][]byte{}
+	uploader := UploaderFunc(func(ctx context.Context, key string, body []byte) error {
+		objects[key] = body
+		return nil
+	})
+
+	ctx := context.Background()
+	archiver := NewArchiver(uploader, "errors/", 2)
+	_ = archiver.Record(ctx, ErrQuery.New().WithCode("QUERY_001"))
+	_ = archiver.Record(ctx, ErrQuery.New().WithCode("QUERY_002")) // full batch is uploaded.
+
+	for key, body := range objects {
+		zr, _ := gzip.NewReader(bytes.NewReader(body))
+		lines, _ := io.ReadAll(zr)
+		fmt.Println(strings.HasPrefix(key, "errors/"), strings.HasSuffix(key, ".jsonl.gz"))
+		fmt.Print(string(lines))
+	}
+
+	// Output:
+	// true true
+	// {"message":"error querying thing","code":"QUERY_001"}
+	// {"message":"error querying thing","code":"QUERY_002"}
+}
+
+func ExampleArchiver_Dropped() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	down := true
+	uploads := 0
+	uploader := UploaderFunc(func(ctx context.Context, key string, body []byte) error {
+		uploads++
+		if down {
+			return errors.New("storage unavailable")
+		}
+		zr, _ := gzip.NewReader(bytes.NewReader(body))
+		lines, _ := io.ReadAll(zr)
+		fmt.Println(strings.Count(string(lines), "\n"), "errors uploaded")
+		return nil
+	})
+
+	ctx := context.Background()
+	archiver := NewArchiver(uploader, "errors/", 1)
+	fmt.Println(archiver.Record(ctx, ErrQuery.New()))
+	for range 11 {
+		_ = archiver.Record(ctx, ErrQuery.New()) // batched, not uploaded.
+	}
+	fmt.Println(uploads, archiver.Dropped())
+
+	down = false
+	fmt.Println(archiver.Flush(ctx))
+
+	// Output:
+	// storage unavailable
+	// 1 2
+	// 10 errors uploaded
+	// <nil>
+}
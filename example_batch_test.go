@@ -0,0 +1,41 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleMarshalErrors() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	b, _ := MarshalErrors([]error{
+		ErrQuery.New().WithCode("QUERY_001").WithLabel("region", "us-east-1"),
+		nil,
+		errors.New("connection reset"),
+	})
+	fmt.Println(string(b))
+
+	// Output:
+	// [{"message":"error querying thing","code":"QUERY_001","labels":{"region":"us-east-1"}},{"message":"connection reset"}]
+}
+
+func ExampleNDJSONWriter() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	w := NewNDJSONWriter(os.Stdout)
+	w.WriteErrors([]error{
+		ErrQuery.New().WithCode("QUERY_001"),
+		ErrQuery.New().WithCode("QUERY_002").WithRetryable(true),
+	})
+
+	// Output:
+	// {"message":"error querying thing","code":"QUERY_001"}
+	// {"message":"error querying thing","code":"QUERY_002","retryable":true}
+}
@@ -0,0 +1,37 @@
+package errific_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleBulkhead() {
+	Configure() // default configuration
+
+	bulkhead := NewBulkhead(1, 0)
+	release := make(chan struct{})
+	running := make(chan struct{})
+
+	go bulkhead.Do(context.Background(), func(ctx context.Context) error {
+		close(running)
+		<-release
+		return nil
+	})
+	<-running
+
+	err := bulkhead.Do(context.Background(), func(ctx context.Context) error {
+		return nil
+	})
+	close(release)
+
+	stats := GetContext(err)
+	fmt.Println(errors.Is(err, ErrBulkheadFull), GetCategory(err), GetHTTPStatus(err), IsRetryable(err))
+	fmt.Println(stats[ContextBulkheadActive], stats[ContextBulkheadLimit], stats[ContextBulkheadQueued])
+
+	// Output:
+	// true server 503 true
+	// 1 1 0
+}
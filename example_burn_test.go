@@ -0,0 +1,66 @@
+package errific_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleBurnAlerter() {
+	Configure(NoCapture)
+	defer Configure()
+
+	var ErrCharge Err = "charge failed"
+
+	journal := NewJournal(100)
+	alerter := NewBurnAlerter(journal, ReporterFunc(func(err error) {
+		fmt.Println(err)
+		ctx := GetContext(err)
+		fmt.Printf("%s %s %.1f %s\n", GetCode(err), ctx[ContextBurnWindow], ctx[ContextBurnRate], ctx[ContextBurnTopCode])
+		fmt.Println(GetDocs(err)[0].URL)
+	}), SLO{
+		Name:      "checkout",
+		Objective: 0.99,
+		Windows:   []BurnWindow{{Name: "fast", Window: time.Hour, Threshold: 10}},
+		Docs:      []Doc{{URL: "https://runbooks.example.com/checkout"}},
+	})
+
+	for i := range 100 {
+		alerter.Request()
+		if i%5 == 0 {
+			journal.Record(ErrCharge.New().WithCode("CHARGE_001"))
+		}
+	}
+
+	alerter.Evaluate()
+
+	// The window is still burning, so it does not alert again.
+	fmt.Println(len(alerter.Evaluate()))
+	// Output:
+	// error budget burning
+	// ERROR_BUDGET_BURN fast 20.0 CHARGE_001
+	// https://runbooks.example.com/checkout
+	// 0
+}
+
+func ExampleSlackMessage() {
+	Configure(NoCapture)
+	defer Configure()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fmt.Println(string(body))
+	}))
+	defer server.Close()
+
+	reporter := WebhookReporter(server.URL, SlackMessage, nil)
+	reporter.Report(ErrBudgetBurn.New().
+		WithCode(CodeBudgetBurn).
+		WithDoc(Doc{URL: "https://runbooks.example.com/checkout", Title: "Checkout runbook"}))
+	// Output:
+	// {"text":"*[ERROR_BUDGET_BURN]* error budget burning\n<https://runbooks.example.com/checkout|Checkout runbook>"}
+}
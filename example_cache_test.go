@@ -0,0 +1,48 @@
+package errific_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleResponseCache() {
+	Configure(NoCapture)
+	var ErrQuery Err = "error querying thing id: '%s'"
+
+	renders := 0
+	responses := NewResponseCache(16, time.Minute, func(err error) ([]byte, error) {
+		renders++
+		return []byte(err.Error()), nil
+	})
+
+	for _, id := range []string{"abc", "abc", "def"} {
+		body, _ := responses.Body(ErrQuery.Errorf(id).WithCode("QUERY_001"))
+		fmt.Println(string(body))
+	}
+	fmt.Println(renders)
+
+	// Output:
+	// error querying thing id: 'abc'
+	// error querying thing id: 'abc'
+	// error querying thing id: 'def'
+	// 2
+}
+
+func ExampleResponseCache_tenants() {
+	Configure(NoCapture)
+	var ErrQuota Err = "quota exceeded"
+
+	responses := NewResponseCache(16, time.Minute, nil)
+
+	for _, tenant := range []string{"acme", "globex", "acme"} {
+		body, _ := responses.Body(ErrQuota.New().WithCode("QUOTA_001").WithTenant(tenant))
+		fmt.Println(string(body))
+	}
+
+	// Output:
+	// {"message":"quota exceeded","code":"QUOTA_001","tenant":"acme"}
+	// {"message":"quota exceeded","code":"QUOTA_001","tenant":"globex"}
+	// {"message":"quota exceeded","code":"QUOTA_001","tenant":"acme"}
+}
@@ -0,0 +1,33 @@
+package errific_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+type renderer struct{}
+
+func (renderer) render() error {
+	var ErrRender Err = "error rendering thing"
+	return ErrRender.New()
+}
+
+func ExampleCallerFormat() {
+	for _, f := range []Option{
+		CallerFormat(ShortFunc),
+		CallerFormat(PkgAndFunc),
+		CallerFormat(FullFunc),
+	} {
+		Configure(f)
+		caller := ResolveChain(renderer{}.render()).Caller
+		fmt.Println(caller[strings.Index(caller, ":")+1:])
+	}
+	Configure()
+
+	// Output:
+	// 14.render
+	// 14.errific_test.renderer.render
+	// 14.github.com/leefernandes/errific_test.renderer.render
+}
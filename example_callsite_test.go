@@ -0,0 +1,27 @@
+package errific_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleTopCallSites() {
+	Configure(CountCallSites)
+	defer Configure()
+	ResetCallSites()
+	var ErrQuery Err = "error querying thing"
+
+	for i := 0; i < 3; i++ {
+		ErrQuery.New()
+	}
+	ErrQuery.New()
+
+	for _, site := range TopCallSites(1) {
+		fmt.Println(strings.HasSuffix(site.Caller, ".ExampleTopCallSites"), site.Count)
+	}
+
+	// Output:
+	// true 3
+}
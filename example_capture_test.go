@@ -0,0 +1,24 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleNoCapture() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrRender Err = "error rendering thing"
+
+	err := ErrRender.New(io.EOF).WithCode("RENDER_001")
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, io.EOF), GetCode(err), ResolveChain(err).Caller == "")
+
+	// Output:
+	// error rendering thing
+	// EOF
+	// true RENDER_001 true
+}
@@ -0,0 +1,29 @@
+package errific_test
+
+import (
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleOutputCI() {
+	Configure(OutputCI)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(CategoryServer).
+		WithLabels(map[string]string{"zone": "a", "region": "us-east-1"}).
+		WithContext(map[string]any{"query": "select\n1"})
+	fmt.Println(err)
+
+	// Output:
+	// message:                 error querying thing
+	// caller:                  example_ci_test.go:14.ExampleOutputCI
+	// code:                    QUERY_001
+	// category:                server
+	// label.region:            us-east-1
+	// label.zone:              a
+	// context.query:           "select\n1"
+}
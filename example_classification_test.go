@@ -0,0 +1,23 @@
+package errific_test
+
+import (
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleGetClassification() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCategory(CategoryNetwork).
+		WithClassification("llm-triage", 0.62)
+
+	if c := GetClassification(err); c != nil && c.Confidence < 0.8 {
+		fmt.Println("ignoring", GetCategory(err), "from", c.Source)
+	}
+
+	// Output:
+	// ignoring network from llm-triage
+}
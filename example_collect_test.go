@@ -0,0 +1,36 @@
+package errific_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleCollectErrors() {
+	Configure() // default configuration
+	var (
+		ErrCache Err = "error caching thing"
+		ErrQuery Err = "error querying thing"
+	)
+
+	mux := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddError(r.Context(), ErrCache.New().WithCode("CACHE_001")) // handled
+		AddError(r.Context(), ErrQuery.New().WithCode("QUERY_001"))
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	handler := CollectErrors(mux, func(r *http.Request, status int, errs []error) {
+		fmt.Println(r.URL.Path, status, len(errs))
+		for _, err := range errs {
+			fmt.Println(GetCode(err))
+		}
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/things", nil))
+
+	// Output:
+	// /things 502 2
+	// CACHE_001
+	// QUERY_001
+}
@@ -0,0 +1,33 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleCompose() {
+	Configure(WithStack)
+	defer Configure()
+
+	info, _ := FromJSON([]byte(`{"message":"error querying thing","code":"QUERY_001","category":"server","labels":{"region":"us-east-1"}}`))
+	err := Compose(info.Message,
+		ComposeInfo(info),
+		ComposeCaller("things/query.go:42.Query"),
+		ComposeStack(Frame{File: "things/handler.go", Line: 17, Function: "Handle"}),
+		ComposeWrapped(io.EOF),
+	)
+
+	fmt.Println(err)
+	fmt.Println(GetCode(err), GetCategory(err), GetLabels(err))
+	fmt.Println(errors.Is(err, io.EOF), errors.Is(err, Err("error querying thing")))
+
+	// Output:
+	// error querying thing [things/query.go:42.Query]
+	// EOF
+	//   things/handler.go:17.Handle
+	// QUERY_001 server map[region:us-east-1]
+	// true true
+}
@@ -0,0 +1,47 @@
+package errific_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleErr_NewCtx() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	fields := GetContext(ErrQuery.NewCtx(ctx, ctx.Err()))
+	fmt.Println(fields[ContextDone], fields[ContextDeadlineRemainingMS].(int64) < 0)
+
+	// Output:
+	// true true
+}
+
+func ExampleGetCancelCause() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+	var ErrShutdown = errors.New("server shutting down")
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(ErrShutdown)
+
+	err := ErrQuery.NewCtx(ctx, ctx.Err())
+	fmt.Println(errors.Is(err, context.Canceled), errors.Is(err, ErrShutdown))
+	fmt.Println(GetCancelCause(err))
+
+	b, _ := json.Marshal(err)
+	fmt.Println(string(b))
+
+	// Output:
+	// true true
+	// server shutting down
+	// {"message":"error querying thing","context":{"ctx_done":true},"cancel_cause":"server shutting down","wrapped":["context canceled"]}
+}
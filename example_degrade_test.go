@@ -0,0 +1,41 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleDegrader() {
+	Configure() // default configuration
+	var ErrCharge Err = "error charging payment"
+
+	journal := NewJournal(16)
+	var degrader *Degrader
+	degrader = NewDegrader(journal, nil, Rule{
+		Name:   "payments-fallback",
+		Code:   "PAYMENT_001",
+		Limit:  1,
+		Window: time.Hour,
+		Degrade: func(err error) {
+			fmt.Println("use fallback provider:", degrader.Degraded("payments-fallback"))
+		},
+		Restore: func(err error) { fmt.Println("use primary provider") },
+	})
+
+	journal.Record(ErrCharge.New().WithCode("PAYMENT_001"))
+	degrader.Evaluate()
+
+	journal.Record(ErrCharge.New().WithCode("PAYMENT_001"))
+	for _, err := range degrader.Evaluate() {
+		fmt.Println(errors.Is(err, ErrDegraded), GetContext(err)[ContextDegradeCount])
+	}
+	fmt.Println(degrader.Degraded("payments-fallback"))
+
+	// Output:
+	// use fallback provider: true
+	// true 2
+	// true
+}
@@ -0,0 +1,30 @@
+package errific_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleErr_New_withDeprecated() {
+	Configure() // default configuration
+	var ErrProcessThing Err = "error processing thing"
+
+	sunset := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+	err := ErrProcessThing.New().
+		WithCode("THING_001").
+		WithDeprecated("THING_NOT_READY", sunset)
+
+	h := EncodeHeader(err)
+	fmt.Println(h.Get("Deprecation"), h.Get("X-Errific-Replacement-Code"), h.Get("Sunset"))
+
+	registry := NewRegistry()
+	registry.Register("THING_001", ErrProcessThing)
+	registry.Deprecate("THING_001", "THING_NOT_READY", sunset)
+	fmt.Println(registry.Verify([]string{GetCode(err)}).Deprecated)
+
+	// Output:
+	// true THING_NOT_READY Fri, 01 Jan 2027 00:00:00 GMT
+	// [THING_001]
+}
@@ -0,0 +1,36 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleDepth() {
+	Configure(ChainStats)
+	defer Configure()
+	var ErrRetry Err = "error retrying thing"
+
+	// a retry loop re-wrapping the same error.
+	var err error = io.EOF
+	for i := 0; i < 3; i++ {
+		err = ErrRetry.New(err)
+	}
+	fmt.Println(Depth(err), WrapCount(err))
+	fmt.Println(Depth(fmt.Errorf("reading: %w", io.EOF)), Depth(io.EOF), Depth(nil))
+
+	var v struct {
+		Depth     int `json:"depth"`
+		WrapCount int `json:"wrap_count"`
+	}
+	b, _ := json.Marshal(err)
+	_ = json.Unmarshal(b, &v)
+	fmt.Println(v.Depth, v.WrapCount)
+
+	// Output:
+	// 4 3
+	// 2 1 0
+	// 4 3
+}
@@ -0,0 +1,37 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleGetDocs() {
+	Configure() // default configuration
+	var ErrMigrate Err = "error migrating things"
+
+	err := ErrMigrate.New().WithCode("MIGRATE_001").WithDoc(
+		Doc{URL: "https://docs.example.com/errors/MIGRATE_001", Title: "Failed migrations"},
+		Doc{
+			URL:     "https://docs.example.com/runbooks/migrations",
+			Title:   "Retry a migration",
+			Snippet: "things migrate --retry",
+			Lang:    "shell",
+		},
+	)
+
+	for _, doc := range GetDocs(err) {
+		fmt.Println(doc.Title, doc.URL)
+	}
+
+	b, _ := json.Marshal(ResolveChain(err).Docs)
+	fmt.Println(string(b))
+	fmt.Println(ToProblemDetails(err).Type)
+
+	// Output:
+	// Failed migrations https://docs.example.com/errors/MIGRATE_001
+	// Retry a migration https://docs.example.com/runbooks/migrations
+	// [{"url":"https://docs.example.com/errors/MIGRATE_001","title":"Failed migrations"},{"url":"https://docs.example.com/runbooks/migrations","title":"Retry a migration","snippet":"things migrate --retry","lang":"shell"}]
+	// https://docs.example.com/errors/MIGRATE_001
+}
@@ -0,0 +1,48 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+var ErrInvalidOrder Err = "invalid order"
+
+func ExampleExpectation() {
+	Configure(NoCapture)
+	defer Configure()
+
+	err := Expectation(ErrInvalidOrder, "currency", "USD", "EUR")
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrInvalidOrder))
+
+	b, _ := json.Marshal(err)
+	fmt.Println(string(b))
+
+	// Output:
+	// invalid order: currency: expected USD, actual EUR
+	// true
+	// {"message":"invalid order: currency: expected USD, actual EUR","expectations":[{"field":"currency","expected":"USD","actual":"EUR"}]}
+}
+
+func ExampleGetExpectations() {
+	Configure(OutputCI, NoCapture)
+	defer Configure()
+	var ErrValidate Err = "error validating order"
+
+	err := ErrValidate.New(
+		Expectation(ErrInvalidOrder, "currency", "USD", ""),
+		Expectation(ErrInvalidOrder, "quantity", 1, 100),
+	)
+	fmt.Println(len(GetExpectations(err)))
+	fmt.Println(err)
+
+	// Output:
+	// 2
+	// message:                 error validating order ↩ invalid order: currency: expected USD, actual  ↩ invalid order: quantity: expected 1, actual 100
+	// expectations:            field     expected  actual
+	//                          currency  "USD"     ""
+	//                          quantity  1         100
+}
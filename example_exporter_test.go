@@ -0,0 +1,61 @@
+package errific_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleExporter() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	sink := func(ctx context.Context, rows []byte) error {
+		// strip the bucket time for stable output.
+		return WriterSink(os.Stdout)(ctx, rows[len(`{"bucket":"2006-01-02T15:04:05Z",`):])
+	}
+
+	exporter := NewExporter(sink, time.Hour, "region")
+	exporter.Record(ErrQuery.New().WithCode("QUERY_001").WithCategory(CategoryServer).WithLabel("region", "us-east-1"))
+	exporter.Record(ErrQuery.New().WithCode("QUERY_001").WithCategory(CategoryServer).WithLabel("region", "us-east-1"))
+
+	if err := exporter.Flush(context.Background()); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// "category":"server","code":"QUERY_001","count":2,"label_region":"us-east-1"}
+}
+
+func ExampleExporter_Anonymize() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	sink := func(ctx context.Context, rows []byte) error {
+		// strip the bucket time for stable output.
+		for _, row := range bytes.SplitAfter(rows, []byte("\n")) {
+			if len(row) > 0 {
+				os.Stdout.Write(row[len(`{"bucket":"2006-01-02T15:04:05Z",`):])
+			}
+		}
+		return nil
+	}
+
+	exporter := NewExporter(sink, time.Hour, "tenant")
+	exporter.Anonymize(2)
+	for _, tenant := range []string{"acme", "acme", "acme", "acme", "globex", "initech", "umbrella"} {
+		exporter.Record(ErrQuery.New().WithCode("QUERY_001").WithLabel("tenant", tenant))
+	}
+
+	if err := exporter.Flush(context.Background()); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// "category":"","code":"QUERY_001","count":4,"label_tenant":"acme"}
+	// "category":"","code":"QUERY_001","count":3,"label_tenant":"*"}
+}
@@ -0,0 +1,48 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	. "github.com/leefernandes/errific"
+)
+
+type Owner struct {
+	Team    string `json:"team"`
+	Channel string `json:"channel"`
+}
+
+var (
+	Priority   = RegisterField("priority", Codec[int]{Label: strconv.Itoa})
+	OwnerField = RegisterField("owner", Codec[Owner]{})
+	Internal   = RegisterField("internal", Codec[string]{Hidden: true})
+)
+
+func ExampleRegisterField() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrCharge Err = "error charging card"
+
+	err := ErrCharge.New().WithFields(
+		Priority.Value(1),
+		OwnerField.Value(Owner{Team: "payments", Channel: "#payments-oncall"}),
+		Internal.Value("shard-7"),
+	)
+
+	priority, _ := Priority.Get(err)
+	internal, _ := Internal.Get(err)
+	fmt.Println(priority, internal, GetLabels(err), ResolveChain(err).Labels)
+
+	b, _ := json.Marshal(err)
+	fmt.Println(string(b))
+
+	info, _ := FromJSON(b)
+	owner, ok, _ := OwnerField.Decode(info)
+	fmt.Println(owner.Team, ok)
+
+	// Output:
+	// 1 shard-7 map[] map[priority:1]
+	// {"message":"error charging card","labels":{"priority":"1"},"fields":{"owner":{"team":"payments","channel":"#payments-oncall"},"priority":1}}
+	// payments true
+}
@@ -0,0 +1,63 @@
+package errific_test
+
+import (
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleFingerprintParts() {
+	var ErrQuery Err = "error querying thing %s"
+
+	// fingerprints do not vary with trimmed paths or formatted arguments.
+	var fingerprints []string
+	for _, arg := range []string{"abc", "xyz"} {
+		if arg == "xyz" {
+			Configure(TrimCWD)
+		}
+		err := ErrQuery.Errorf(arg)
+		fmt.Println(FingerprintParts(err))
+		fingerprints = append(fingerprints, Fingerprint(err))
+	}
+	Configure()
+	fmt.Println(fingerprints[0] == fingerprints[1])
+
+	// Output:
+	// [github.com/leefernandes/errific_test/example_fingerprint_test.go:18.ExampleFingerprintParts||error querying thing %s]
+	// [github.com/leefernandes/errific_test/example_fingerprint_test.go:18.ExampleFingerprintParts||error querying thing %s]
+	// true
+}
+
+func ExampleFingerprint_callerFormat() {
+	var fingerprints []string
+	for _, f := range []Option{
+		CallerFormat(ShortFunc),
+		CallerFormat(PkgAndFunc),
+		CallerFormat(FullFunc),
+	} {
+		Configure(f)
+		err := renderer{}.render()
+		fingerprints = append(fingerprints, Fingerprint(err))
+		if len(fingerprints) == 1 {
+			fmt.Println(FingerprintParts(err))
+		}
+	}
+	Configure()
+	fmt.Println(fingerprints[0] == fingerprints[1], fingerprints[1] == fingerprints[2])
+
+	// Output:
+	// [github.com/leefernandes/errific_test/example_callerformat_test.go:14.renderer.render||error rendering thing]
+	// true true
+}
+
+func ExampleFingerprint_packages() {
+	// callers of files with the same name in different packages differ.
+	users := Compose("error handling request", ComposeCaller("internal/users/handler.go:42.Handle"))
+	orders := Compose("error handling request", ComposeCaller("internal/orders/handler.go:42.Handle"))
+	fmt.Println(FingerprintParts(users), FingerprintParts(orders))
+	fmt.Println(Fingerprint(users) == Fingerprint(orders))
+
+	// Output:
+	// [users/handler.go:42.Handle||error handling request] [orders/handler.go:42.Handle||error handling request]
+	// false
+}
@@ -0,0 +1,35 @@
+package errific_test
+
+import (
+	"fmt"
+	"io"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleRegisterFormat() {
+	RegisterFormat("ltsv", func(info ErrorInfo) ([]byte, error) {
+		return []byte("message:" + info.Message + "\tcode:" + info.Code), nil
+	})
+	Configure(OutputNamed("ltsv"))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	fmt.Println(ErrQuery.New().WithCode("QUERY_001"))
+
+	// Output:
+	// message:error querying thing	code:QUERY_001
+}
+
+func ExampleOutputNamed_wrapped() {
+	Configure(OutputNamed("json"), NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	fmt.Println(ErrQuery.New(io.EOF).WithCode("QUERY_001"))
+	fmt.Println(ErrQuery.New(fmt.Errorf("db down")))
+
+	// Output:
+	// {"message":"error querying thing ↩ EOF","code":"QUERY_001"}
+	// {"message":"error querying thing ↩ db down"}
+}
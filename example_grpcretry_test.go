@@ -0,0 +1,35 @@
+package errific_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleNewGRPCRetryPolicy() {
+	Configure() // default configuration
+	var (
+		ErrUnavailable Err = "error calling thing service"
+		ErrTimeout     Err = "timeout calling thing service"
+		ErrInvalid     Err = "invalid thing"
+	)
+
+	policy := NewGRPCRetryPolicy(4,
+		ErrUnavailable.New().WithCategory(CategoryNetwork).WithRetryable(true).WithRetryAfter(200*time.Millisecond),
+		ErrTimeout.New().WithCategory(CategoryTimeout).WithRetryable(true).WithRetryAfter(2*time.Second),
+		ErrInvalid.New().WithCategory(CategoryValidation),
+	)
+	config, _ := policy.ServiceConfig("things.v1.ThingService")
+	fmt.Println(string(config))
+
+	// and back, to mark errors retryable like clients retry them.
+	policy, _ = ParseGRPCRetryPolicy(config)
+	fmt.Println(policy.Retryable(ErrTimeout.New().WithCategory(CategoryTimeout)))
+	fmt.Println(policy.Retryable(ErrInvalid.New().WithCategory(CategoryValidation)))
+
+	// Output:
+	// {"methodConfig":[{"name":[{"service":"things.v1.ThingService"}],"retryPolicy":{"maxAttempts":4,"initialBackoff":"0.2s","maxBackoff":"2s","backoffMultiplier":2,"retryableStatusCodes":["DEADLINE_EXCEEDED","UNAVAILABLE"]}}]}
+	// true
+	// false
+}
@@ -0,0 +1,81 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleEncodeHeader() {
+	Configure() // default configuration
+	var ErrExample Err = "example error"
+	err := ErrExample.New().
+		WithCode("EXAMPLE_001").
+		WithCategory(CategoryServer).
+		WithCorrelationID("abc-123").
+		WithRetryAfter(1500 * time.Millisecond)
+
+	h := EncodeHeader(err)
+	fmt.Println(h.Get(HeaderCode))
+	fmt.Println(h.Get(HeaderCategory))
+	fmt.Println(h.Get(HeaderCorrelationID))
+	fmt.Println(h.Get(HeaderRetryable))
+	fmt.Println(h.Get(HeaderRetryAfter))
+
+	// Output:
+	// EXAMPLE_001
+	// server
+	// abc-123
+	// true
+	// 1.5
+}
+
+func ExampleDecodeHeader() {
+	Configure() // default configuration
+	var ErrExample Err = "example error"
+	err := ErrExample.New().
+		WithCode("EXAMPLE_001").
+		WithCategory(CategoryServer).
+		WithCorrelationID("abc-123").
+		WithRetryAfter(2 * time.Second)
+
+	info := DecodeHeader(EncodeHeader(err))
+	fmt.Println(info.Code)
+	fmt.Println(info.Category)
+	fmt.Println(info.CorrelationID)
+	fmt.Println(info.Retryable)
+	fmt.Println(info.RetryAfter)
+
+	// Output:
+	// EXAMPLE_001
+	// server
+	// abc-123
+	// true
+	// 2s
+}
+
+func ExampleFromHeader() {
+	Configure() // default configuration
+	var ErrExample Err = "example error"
+	err := ErrExample.New().
+		WithCode("EXAMPLE_001").
+		WithCategory(CategoryServer).
+		WithRequestID("req-123").
+		WithRetryAfter(2 * time.Second)
+
+	// the server encodes the headers, the client reconstructs the error.
+	remote := FromHeader(EncodeHeader(err))
+	fmt.Println(errors.Is(remote, ErrRemote))
+	fmt.Println(GetCode(remote), GetCategory(remote), GetRequestID(remote))
+	fmt.Println(IsRetryable(remote), GetRetryAfter(remote))
+	fmt.Println(FromHeader(http.Header{}))
+
+	// Output:
+	// true
+	// EXAMPLE_001 server req-123
+	// true 2s
+	// <nil>
+}
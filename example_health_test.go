@@ -0,0 +1,32 @@
+package errific_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleHealthHandler() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	journal := NewJournal(16)
+	thresholds := Thresholds{Degraded: 2, Unhealthy: 3}
+	handler := HealthHandler(journal, thresholds)
+
+	for i := 0; i < 3; i++ {
+		journal.Record(ErrQuery.New().WithCode("QUERY_001").WithCategory(CategoryServer))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		health := journal.Health(thresholds)
+		fmt.Println(w.Code, health.Status, health.Summaries[0].Code, health.Summaries[0].Count)
+	}
+
+	// Output:
+	// 200 healthy QUERY_001 1
+	// 200 degraded QUERY_001 2
+	// 503 unhealthy QUERY_001 3
+}
@@ -0,0 +1,43 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleNewHeatmap() {
+	wd, _ := os.Getwd()
+	Configure(TrimPrefixes(wd + "/"))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	journal := NewJournal(16)
+	for _, code := range []string{"QUERY_002", "QUERY_001", "QUERY_001"} {
+		journal.Record(ErrQuery.New().WithCode(code))
+	}
+	journal.Record(ErrQuery.New())
+
+	b, _ := json.MarshalIndent(NewHeatmap(journal.Records()), "", "  ")
+	fmt.Println(string(b))
+
+	// Output:
+	// {
+	//   "example_heatmap_test.go": [
+	//     {
+	//       "line": 19,
+	//       "count": 3,
+	//       "codes": [
+	//         "QUERY_001",
+	//         "QUERY_002"
+	//       ]
+	//     },
+	//     {
+	//       "line": 21,
+	//       "count": 1
+	//     }
+	//   ]
+	// }
+}
@@ -0,0 +1,31 @@
+package errific_test
+
+import (
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleInheritMetadata() {
+	Configure(InheritMetadata)
+	var (
+		ErrQuery  Err = "error querying thing"
+		ErrHandle Err = "error handling request"
+	)
+
+	err1 := ErrQuery.New().
+		WithCorrelationID("abc-123").
+		WithTenant("acme").
+		WithLabel("region", "us-east-1")
+	err2 := ErrHandle.New(err1).
+		WithLabel("route", "/things")
+
+	fmt.Println(GetCorrelationID(err2))
+	fmt.Println(GetTenant(err2))
+	fmt.Println(GetLabels(err2))
+
+	// Output:
+	// abc-123
+	// acme
+	// map[region:us-east-1 route:/things]
+}
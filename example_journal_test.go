@@ -0,0 +1,46 @@
+package errific_test
+
+import (
+	"fmt"
+	"io"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleJournal() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	journal := NewJournal(2)
+	journal.Record(ErrQuery.New().WithCode("QUERY_001"))
+	journal.Record(ErrQuery.New().WithCode("QUERY_002"))
+	journal.Record(io.EOF)
+
+	for _, r := range journal.Records() {
+		fmt.Printf("%q %q\n", r.Code, r.Message)
+	}
+	fmt.Println(journal.Len(), journal.Total())
+
+	// Output:
+	// "QUERY_002" "error querying thing"
+	// "" "EOF"
+	// 2 3
+}
+
+func ExampleAggregator() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	aggregator := NewAggregator(1)
+	aggregator.Record(ErrQuery.New().WithCode("QUERY_001"))
+	aggregator.Record(ErrQuery.New().WithCode("QUERY_001"))
+	aggregator.Record(ErrQuery.New().WithCode("QUERY_002"))
+
+	for _, count := range aggregator.Counts() {
+		fmt.Println(count.Code, count.Count)
+	}
+
+	// Output:
+	// QUERY_001 2
+	// _overflow 1
+}
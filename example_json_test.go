@@ -0,0 +1,74 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleMaxJSONSize() {
+	Configure(MaxJSONSize(512))
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithContext(map[string]any{"query": strings.Repeat("x", 1024)})
+
+	b, _ := json.Marshal(err)
+	var v struct {
+		Code    string         `json:"code"`
+		Context map[string]any `json:"context"`
+		Dropped []string       `json:"dropped"`
+	}
+	json.Unmarshal(b, &v)
+	fmt.Println(v.Code, v.Context, v.Dropped)
+	fmt.Println(SerializedSize(err) <= 512)
+
+	// Output:
+	// QUERY_001 map[] [context]
+	// true
+}
+
+func ExampleCompressContext() {
+	Configure(CompressContext(64))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithContext(map[string]any{"query": strings.Repeat("x", 1024)})
+
+	b, _ := json.Marshal(err)
+	fmt.Println(len(b) < 512, strings.Contains(string(b), `"_encoding":"gzip+base64"`))
+
+	info, _ := FromJSON(b)
+	fmt.Println(len(info.Context["query"].(string)))
+
+	// Output:
+	// true true
+	// 1024
+}
+
+func ExampleErrorInfo_unserializable() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithContext(map[string]any{"done": make(chan struct{}), "attempt": 2})
+
+	b, _ := json.Marshal(err)
+	info, _ := FromJSON(b)
+	fmt.Println(info.Code, info.Context)
+
+	b, _ = MarshalErrors([]error{err})
+	var infos []ErrorInfo
+	json.Unmarshal(b, &infos)
+	fmt.Println(infos[0].Code, infos[0].Context)
+
+	// Output:
+	// QUERY_001 map[attempt:2 done:<unserializable chan struct {}>]
+	// QUERY_001 map[attempt:2 done:<unserializable chan struct {}>]
+}
@@ -0,0 +1,48 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleToJSONAPIErrors() {
+	Configure() // default configuration
+	var ErrValidate Err = "invalid thing"
+	var ErrCreate Err = "error creating thing"
+
+	err := ErrValidate.New().
+		WithCode("THING_NAME").
+		WithCategory(CategoryValidation).
+		WithContext(map[string]any{ContextSourcePointer: "/data/attributes/name", "max_length": 64})
+	err = ErrCreate.New(err).WithCode("THING_CREATE").WithRequestID("req-123")
+
+	b, _ := json.MarshalIndent(map[string]any{"errors": ToJSONAPIErrors(err)}, "", "  ")
+	fmt.Println(string(b))
+
+	// Output:
+	// {
+	//   "errors": [
+	//     {
+	//       "id": "req-123",
+	//       "status": "500",
+	//       "code": "THING_CREATE",
+	//       "title": "Internal Server Error",
+	//       "detail": "error creating thing"
+	//     },
+	//     {
+	//       "status": "400",
+	//       "code": "THING_NAME",
+	//       "title": "Bad Request",
+	//       "detail": "invalid thing",
+	//       "source": {
+	//         "pointer": "/data/attributes/name"
+	//       },
+	//       "meta": {
+	//         "max_length": 64
+	//       }
+	//     }
+	//   ]
+	// }
+}
@@ -0,0 +1,26 @@
+package errific_test
+
+import (
+	"fmt"
+	"net/http"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleLogLevel() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	fmt.Println(LogLevel(ErrQuery.New().WithCategory(CategoryValidation)))
+	fmt.Println(LogLevel(ErrQuery.New().WithCategory(CategoryServer)))
+	fmt.Println(LogLevel(ErrQuery.New().WithHTTPStatus(http.StatusConflict)))
+
+	Configure(LogLevelFor(LevelCritical, CategoryServer))
+	fmt.Println(LogLevel(ErrQuery.New().WithCategory(CategoryServer)))
+
+	// Output:
+	// WARN
+	// ERROR
+	// WARN
+	// ERROR+4
+}
@@ -0,0 +1,43 @@
+package errific_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+// germanNumber formats integers with . as the thousands separator.
+func germanNumber(n any) string {
+	s := fmt.Sprint(n)
+	if _, err := strconv.Atoi(s); err != nil {
+		return strings.ReplaceAll(s, ".", ",")
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "." + s[i:]
+	}
+	return s
+}
+
+func ExampleLocalize() {
+	Configure(OutputCI, NoCapture, Localize(Locale{
+		Duration: func(d time.Duration) string { return fmt.Sprintf("%.0f Sekunden", d.Seconds()) },
+		Number:   germanNumber,
+	}))
+	defer Configure()
+	var ErrQuota Err = "quota exceeded"
+
+	err := ErrQuota.New().
+		WithRetryAfter(30 * time.Second).
+		WithContext(map[string]any{"used": 1250000, "ratio": 1.25})
+	fmt.Println(err)
+
+	// Output:
+	// message:                 quota exceeded
+	// retryable:               true
+	// retry_after:             30 Sekunden
+	// context.ratio:           1,25
+	// context.used:            1.250.000
+}
@@ -0,0 +1,43 @@
+package errific_test
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleOutputLogfmt() {
+	Configure(OutputLogfmt)
+	defer Configure()
+	var ErrQuery Err = "error querying thing id: '%s'"
+
+	err := ErrQuery.Errorf("a=b").
+		WithCode("QUERY_001").
+		WithLabel("region", "us-east-1").
+		WithContext(map[string]any{"query": `select "name"`})
+
+	// strip the caller for stable output.
+	fmt.Println(strings.Replace(err.Error(), " caller="+ResolveChain(err).Caller, "", 1))
+
+	// Output:
+	// message="error querying thing id: 'a=b'" code=QUERY_001 label.region=us-east-1 context.query="select \"name\""
+}
+
+func ExampleOutputLogfmt_metadata() {
+	Configure(OutputLogfmt, NoCapture)
+	defer Configure()
+	var ErrValidate Err = "error validating order"
+
+	err := ErrValidate.New(Expectation(ErrInvalidOrder, "currency", "USD", "EUR")).
+		WithCode("ORDER_001").
+		WithClassification("classifier", 0.8).
+		WithDeprecated("ORDER_002", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WithDoc(Doc{URL: "https://docs.example.com/orders", Title: "Orders"})
+
+	fmt.Println(err)
+
+	// Output:
+	// message="error validating order ↩ invalid order: currency: expected USD, actual EUR" code=ORDER_001 classification.source=classifier classification.confidence=0.8 deprecation.replacement=ORDER_002 deprecation.sunset=2027-01-01T00:00:00Z expectation.currency.expected=USD expectation.currency.actual=EUR doc.0.url=https://docs.example.com/orders doc.0.title=Orders
+}
@@ -0,0 +1,61 @@
+package errific_test
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func Example_logValue() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	// unset fields are omitted.
+	logger.Error("error handling request", "err", ErrQuery.New(io.EOF))
+
+	logger.Error("error handling request", "err", ErrQuery.New(io.EOF).
+		WithCode("QUERY_001").
+		WithCategory(CategoryServer).
+		WithHTTPStatus(503).
+		WithContext(map[string]any{"table": "things"}))
+
+	// Output:
+	// {"level":"ERROR","msg":"error handling request","err":{"message":"error querying thing"}}
+	// {"level":"ERROR","msg":"error handling request","err":{"message":"error querying thing","code":"QUERY_001","category":"server","http_status":503,"context":{"table":"things"}}}
+}
+
+func Example_logValueMetadata() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrValidate Err = "error validating order"
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Error("error handling request", "err", ErrValidate.New(Expectation(ErrInvalidOrder, "currency", "USD", "EUR")).
+		WithClassification("classifier", 0.8).
+		WithDeprecated("ORDER_002", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WithDoc(Doc{URL: "https://docs.example.com/orders", Title: "Orders"}))
+
+	// Output:
+	// {"level":"ERROR","msg":"error handling request","err":{"message":"error validating order","classification":{"source":"classifier","confidence":0.8},"deprecation":{"replacement":"ORDER_002","sunset":"2027-01-01T00:00:00Z"},"expectations":{"currency":{"expected":"USD","actual":"EUR"}},"docs":{"0":{"url":"https://docs.example.com/orders","title":"Orders"}}}}
+}
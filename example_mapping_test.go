@@ -0,0 +1,28 @@
+package errific_test
+
+import (
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleLoadMappings() {
+	m, err := LoadMappings("testdata/mappings.json")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	Configure(UseMappings(m))
+	defer Configure()
+	var ErrValidateThing Err = "error validating thing"
+
+	err = ErrValidateThing.New().WithCode("THING_001").WithCategory(CategoryValidation)
+	fmt.Println(MapHTTPStatus(err), MapGRPCCode(err), MapMCPCode(err))
+
+	err = ErrValidateThing.New().WithCategory(CategoryNotFound)
+	fmt.Println(MapHTTPStatus(err), MapGRPCCode(err), MapMCPCode(err))
+
+	// Output:
+	// 422 FAILED_PRECONDITION -32602
+	// 404 NOT_FOUND -32603
+}
@@ -0,0 +1,30 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleMustGetCode() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	code, err := MustGetCode(ErrQuery.New().WithCode("QUERY_001"))
+	fmt.Println(code, err)
+
+	_, err = MustGetCode(ErrQuery.New())
+	fmt.Println(errors.Is(err, ErrMissingMetadata), errors.Is(err, ErrQuery))
+
+	Configure(PanicOnMissingMetadata)
+	defer func() {
+		fmt.Println(errors.Is(recover().(error), ErrMissingMetadata))
+	}()
+	MustGetHTTPStatus(ErrQuery.New())
+
+	// Output:
+	// QUERY_001 <nil>
+	// true true
+	// true
+}
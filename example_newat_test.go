@@ -0,0 +1,26 @@
+package errific_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+var ErrRead Err = "error reading thing"
+
+// readErr creates the errors of its callers.
+func readErr(err error) error {
+	return ErrRead.NewAt(1, err)
+}
+
+func ExampleErr_NewAt() {
+	Configure() // default configuration
+
+	err := readErr(io.EOF)
+	fmt.Println(strings.HasSuffix(ResolveChain(err).Caller, ".ExampleErr_NewAt"))
+
+	// Output:
+	// true
+}
@@ -0,0 +1,34 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleFlagNilErrors() {
+	Configure(Disabled)
+	defer Configure()
+	var ErrRead Err = "error reading thing"
+
+	// nil errors are dropped.
+	var err error
+	err = ErrRead.New(nil, io.EOF)
+	fmt.Println(err)
+
+	// or flagged in development.
+	Configure(Disabled, FlagNilErrors)
+	err = ErrRead.New(nil, io.EOF)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrNilWrapped))
+
+	// Output:
+	// error reading thing
+	// EOF
+	// error reading thing
+	// nil error wrapped: argument 0
+	// EOF
+	// true
+}
@@ -0,0 +1,29 @@
+package errific_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func validate(user string) error {
+	return errors.New("invalid user " + user)
+}
+
+func ExampleOriginExpr() {
+	Configure(OriginExpr)
+	defer Configure()
+	var ErrSave Err = "error saving user"
+
+	err := validate("gopher")
+	fmt.Println(GetContext(ErrSave.New(err))[ContextOriginExpr])
+	fmt.Println(GetContext(ErrSave.New(validate("gopher")))[ContextOriginExpr])
+	fmt.Println(GetContext(ErrSave.NewCtx(context.Background(), err))[ContextOriginExpr])
+
+	// Output:
+	// validate("gopher")
+	// validate("gopher")
+	// validate("gopher")
+}
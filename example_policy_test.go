@@ -0,0 +1,31 @@
+package errific_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleDecide() {
+	Configure(UsePolicy(Policy{
+		Rules: []PolicyRule{
+			{Name: "cache", Code: "QUERY_001", Action: ActionFallback, Fallback: "cache"},
+			{Category: CategoryValidation, Action: ActionSuppress},
+			{Labels: map[string]string{"tier": "critical"}, Action: ActionEscalate},
+		},
+	}))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	fmt.Printf("%+v\n", Decide(ErrQuery.New().WithCode("QUERY_001")))
+	fmt.Printf("%+v\n", Decide(ErrQuery.New().WithCategory(CategoryValidation)))
+	fmt.Printf("%+v\n", Decide(ErrQuery.New().WithRetryable(true).WithRetryAfter(time.Second)))
+	fmt.Printf("%+v\n", Decide(ErrQuery.New().WithRetryable(true).WithLabels(map[string]string{"tier": "critical"})))
+
+	// Output:
+	// {Action:fallback Rule:cache Fallback:cache RetryAfter:0s}
+	// {Action:suppress Rule: Fallback: RetryAfter:0s}
+	// {Action:retry Rule: Fallback: RetryAfter:1s}
+	// {Action:escalate Rule: Fallback: RetryAfter:0s}
+}
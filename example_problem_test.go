@@ -0,0 +1,46 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleToProblemDetails() {
+	Configure(DocsURL("https://docs.example.com/errors"))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithRequestID("req-123").
+		WithHTTPStatus(http.StatusServiceUnavailable).
+		WithRetryable(true).
+		WithRetryAfter(1500 * time.Millisecond).
+		WithContext(map[string]any{"table": "things"})
+
+	b, _ := json.Marshal(ToProblemDetails(err))
+	fmt.Println(string(b))
+
+	// Output:
+	// {"type":"https://docs.example.com/errors/QUERY_001","title":"Service Unavailable","status":503,"detail":"error querying thing","instance":"req-123","code":"QUERY_001","retryable":true,"retry_after":2,"context":{"table":"things"}}
+}
+
+func ExampleWriteProblem() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	w := httptest.NewRecorder()
+	WriteProblem(w, ErrQuery.New().WithCode("QUERY_001").WithContext(map[string]any{"table": "things"}))
+
+	fmt.Println(w.Code, w.Header().Get("Content-Type"))
+	fmt.Print(w.Body.String())
+
+	// Output:
+	// 500 application/problem+json
+	// {"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Internal Server Error","code":"QUERY_001"}
+}
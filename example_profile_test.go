@@ -0,0 +1,30 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+// ErrLibQuery is an error of a library, formatted inline without caller
+// whatever the application configures.
+var ErrLibQuery = Err("lib: error querying thing").WithOptions(Inline, Disabled)
+
+func ExampleErr_WithOptions() {
+	Configure(Prefix, Newline)
+	defer Configure()
+	var ErrApp Err = "error handling request"
+
+	fmt.Println(ErrLibQuery.New(io.EOF))
+	err := ErrApp.New(ErrLibQuery.New(io.EOF))
+	fmt.Println(strings.HasPrefix(err.Error(), "["), strings.HasSuffix(err.Error(), "\nlib: error querying thing ↩ EOF"))
+	fmt.Println(errors.Is(err, ErrLibQuery), errors.Is(err, Err("lib: error querying thing")))
+
+	// Output:
+	// lib: error querying thing ↩ EOF
+	// true true
+	// true false
+}
@@ -0,0 +1,32 @@
+package errific_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleQueueFull() {
+	Configure() // default configuration
+
+	err := QueueFull("jobs", 150, 100)
+	fmt.Println(GetCode(err), GetRetryAfter(err), GetContext(err)[ContextQueueUtilization])
+
+	// Output:
+	// QUEUE_FULL 150ms 1.5
+}
+
+func ExampleSend() {
+	Configure() // default configuration
+	jobs := make(chan int, 1)
+
+	fmt.Println(Send(context.Background(), jobs, 1, "jobs", 0))
+	err := Send(context.Background(), jobs, 2, "jobs", time.Millisecond)
+	fmt.Println(GetCode(err), IsRetryable(err), GetRetryAfter(err))
+
+	// Output:
+	// <nil>
+	// QUEUE_TIMEOUT true 100ms
+}
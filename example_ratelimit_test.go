@@ -0,0 +1,23 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleRateLimited() {
+	Configure() // default configuration
+
+	err := RateLimited(100, 0, time.Now().Add(30*time.Second))
+	fmt.Println(errors.Is(err, ErrRateLimited), GetCategory(err), GetHTTPStatus(err), IsRetryable(err))
+
+	h := RateLimitHeader(err)
+	fmt.Println(h.Get("RateLimit-Limit"), h.Get("RateLimit-Remaining"), h.Get("RateLimit-Reset"), h.Get("Retry-After"))
+
+	// Output:
+	// true rate_limited 429 true
+	// 100 0 30 30
+}
@@ -0,0 +1,39 @@
+package errific_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleRecover() {
+	Configure() // default configuration
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /things/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var things map[string]string
+		things[r.PathValue("id")] = "thing" // nil map
+	})
+
+	handler := Recover(mux, func(r *http.Request, err error) {
+		context := GetContext(err)
+		fmt.Println(GetCode(err) == "", GetHTTPStatus(err), GetCorrelationID(err))
+		fmt.Println(context[ContextMethod], context[ContextPath], context[ContextRoute], context[ContextRemoteAddr])
+		fmt.Println(context[ContextPanic])
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/things/abc?token=secret", nil)
+	r.RemoteAddr = "203.0.113.7:52100"
+	r.Header.Set("X-Request-Id", "req-1")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	fmt.Println(w.Code)
+
+	// Output:
+	// true 500 req-1
+	// GET /things/abc GET /things/{id} 203.0.113.7
+	// assignment to entry in nil map
+	// 500
+}
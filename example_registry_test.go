@@ -0,0 +1,49 @@
+package errific_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleRegistry_Verify() {
+	Configure() // default configuration
+	registry := NewRegistry()
+	ErrQuery := registry.Register("QUERY_001", "error querying thing")
+	registry.Register("LEGACY_001", "legacy error")
+
+	err1 := ErrQuery.New().WithCode("QUERY_001")
+	err2 := Err("error handling request").New().WithCode("HANDLE_001")
+
+	v := registry.Verify([]string{GetCode(err1), GetCode(err2)})
+	fmt.Println(v.OK())
+	fmt.Println(v.Unregistered)
+	fmt.Println(v.Unused)
+
+	// Output:
+	// false
+	// [HANDLE_001]
+	// [LEGACY_001]
+}
+
+func ExampleRegistry_NewCode() {
+	Configure() // default configuration
+	registry := NewRegistry()
+	registry.Register("QUERY_001", "error querying thing")
+
+	if e, ok := registry.Lookup("QUERY_001"); ok {
+		fmt.Println(e)
+	}
+
+	err := registry.NewCode("QUERY_001")
+	fmt.Println(GetCode(err), errors.Is(err, registry.MustErr("QUERY_001")))
+
+	err = registry.NewCode("QUERY_404")
+	fmt.Println(errors.Is(err, ErrUnregisteredCode), GetContext(err)[ContextUnregisteredCode])
+
+	// Output:
+	// error querying thing
+	// QUERY_001 true
+	// true QUERY_404
+}
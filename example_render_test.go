@@ -0,0 +1,33 @@
+package errific_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleRender() {
+	Configure() // default configuration
+	var ErrNotFound Err = "thing not found"
+	err := ErrNotFound.New().WithCode("THING_404").WithCategory(CategoryNotFound)
+
+	for _, accept := range []string{"application/problem+json", "text/html, application/json;q=0.9", "*/*"} {
+		r := httptest.NewRequest(http.MethodGet, "/things/1", nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		Render(w, r, err)
+
+		fmt.Println(w.Code, w.Header().Get("Content-Type"))
+		fmt.Print(w.Body.String())
+	}
+
+	// Output:
+	// 404 application/problem+json
+	// {"type":"about:blank","title":"Not Found","status":404,"detail":"thing not found","code":"THING_404"}
+	// 404 application/json
+	// {"message":"thing not found","code":"THING_404","category":"not_found"}
+	// 404 text/plain; charset=utf-8
+	// thing not found
+}
@@ -0,0 +1,44 @@
+package errific_test
+
+import (
+	"fmt"
+	"log/slog"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleReport() {
+	journal := NewJournal(16)
+	pager := ReporterFunc(func(err error) {
+		fmt.Println("paged:", GetCode(err))
+	})
+	Configure(Reporters(
+		ReporterFunc(journal.Record),
+		FilterReporter(pager, slog.LevelError, CategoryServer),
+	))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	Report(ErrQuery.New().WithCode("QUERY_001").WithCategory(CategoryServer))
+	Report(ErrQuery.New().WithCode("QUERY_002").WithCategory(CategoryNotFound))
+	Report(nil)
+	fmt.Println("journaled:", journal.Len())
+
+	// Output:
+	// paged: QUERY_001
+	// journaled: 2
+}
+
+func ExampleReportCreated() {
+	journal := NewJournal(16)
+	Configure(ReportCreated, Reporters(ReporterFunc(journal.Record)))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	_ = ErrQuery.New().WithCode("QUERY_001")
+	_ = ErrQuery.Withf("id: '%s'", "abc")
+	fmt.Println("journaled:", journal.Len())
+
+	// Output:
+	// journaled: 2
+}
@@ -0,0 +1,77 @@
+package errific_test
+
+import (
+	"fmt"
+	"net/http"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleResolveChain() {
+	Configure() // default configuration
+	var (
+		ErrQuery  Err = "error querying thing"
+		ErrHandle Err = "error handling request"
+	)
+
+	err1 := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithHTTPStatus(http.StatusServiceUnavailable)
+	err2 := ErrHandle.New(err1).
+		WithCode("HANDLE_001").
+		WithHTTPStatus(http.StatusInternalServerError)
+
+	info := ResolveChain(err2)
+	fmt.Println(info.Code, info.HTTPStatus)
+
+	Configure(Resolve(Innermost, FieldCode, FieldHTTPStatus))
+	info = ResolveChain(err2)
+	fmt.Println(info.Code, info.HTTPStatus)
+	fmt.Println(GetCode(err2), GetHTTPStatus(err2))
+
+	// Output:
+	// HANDLE_001 500
+	// QUERY_001 503
+	// QUERY_001 503
+}
+
+func ExampleChain() {
+	Configure() // default configuration
+	var (
+		ErrQuery  Err = "error querying thing"
+		ErrHandle Err = "error handling request"
+	)
+
+	err := ErrHandle.New(
+		ErrQuery.New().WithCode("QUERY_001").WithCategory(CategoryTimeout),
+	).WithCode("HANDLE_001").WithCategory(CategoryServer)
+
+	for i, info := range Chain(err) {
+		fmt.Printf("error.chain.%d.code=%s error.chain.%d.category=%s\n", i, info.Code, i, info.Category)
+	}
+
+	// Output:
+	// error.chain.0.code=HANDLE_001 error.chain.0.category=server
+	// error.chain.1.code=QUERY_001 error.chain.1.category=timeout
+}
+
+func ExampleInfo() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	err := fmt.Errorf("handling request: %w", ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(CategoryServer).
+		WithCorrelationID("abc").
+		WithRetryable(true))
+
+	info, ok := Info(err)
+	fmt.Println(ok, info.Message, info.Code, info.Category, info.CorrelationID, info.Retryable)
+
+	_, ok = Info(fmt.Errorf("other error"))
+	fmt.Println(ok)
+
+	// Output:
+	// true error querying thing QUERY_001 server abc true
+	// false
+}
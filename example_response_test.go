@@ -0,0 +1,42 @@
+package errific_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleFromHTTPResponse() {
+	Configure() // default configuration
+
+	req := httptest.NewRequest(http.MethodGet, "https://things.example.com/things/1?token=secret", nil)
+	resp := &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header: http.Header{
+			"Retry-After":  {"3"},
+			"X-Request-Id": {"req-123"},
+		},
+		Request: req,
+	}
+	body := []byte(`{"error":"overloaded"}`)
+
+	err := FromHTTPResponse(resp, body)
+	info := ResolveChain(err)
+	fmt.Println(info.HTTPStatus, info.Category, info.Retryable, info.RetryAfter, info.RequestID)
+	fmt.Println(info.Upstream.Service, info.Context[ContextHTTPURL])
+	fmt.Println(info.Context[ContextResponseBody])
+
+	resp.StatusCode = http.StatusNotFound
+	resp.Header = http.Header{}
+	err = FromHTTPResponse(resp, []byte(strings.Repeat("x", 8)))
+	fmt.Println(GetCategory(err), IsRetryable(err))
+
+	// Output:
+	// 503 network true 3s req-123
+	// things.example.com https://things.example.com/things/1
+	// {"error":"overloaded"}
+	// not_found false
+}
@@ -0,0 +1,42 @@
+package errific_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	. "github.com/leefernandes/errific"
+)
+
+func importUser(ctx context.Context, id int) (err error) {
+	ctx, finish := BeginScope(ctx, "import user")
+	defer func() { err = finish(err) }()
+
+	AddScopeContext(ctx, map[string]any{"user_id": id})
+	return io.ErrUnexpectedEOF
+}
+
+func ExampleBeginScope() {
+	Configure(Disabled)
+	defer Configure()
+
+	ctx, finish := BeginScope(context.Background(), "import users")
+	AddScopeContext(ctx, map[string]any{"batch": 7})
+	err := finish(importUser(ctx, 42))
+
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, io.ErrUnexpectedEOF))
+
+	for _, info := range Chain(err) {
+		fmt.Println(info.Context[ContextScope], info.Context["batch"], info.Context["user_id"])
+	}
+
+	// Output:
+	// import users
+	// import user
+	// unexpected EOF
+	// true
+	// import users 7 <nil>
+	// import users > import user 7 42
+}
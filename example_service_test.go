@@ -0,0 +1,19 @@
+package errific_test
+
+import (
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleServiceIdentity() {
+	Configure(ServiceIdentity("billing", "v1.4.2", "production"))
+	defer Configure()
+	var ErrCharge Err = "error charging payment"
+
+	service := GetService(ErrCharge.New())
+	fmt.Println(service.Name, service.Version, service.Env)
+
+	// Output:
+	// billing v1.4.2 production
+}
@@ -0,0 +1,26 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleShadow() {
+	// the candidate format drops the caller.
+	RegisterFormat("json-v2", func(info ErrorInfo) ([]byte, error) {
+		info.Caller = ""
+		return json.Marshal(info)
+	})
+	Configure(OutputNamed("json"), Shadow("json-v2", 1, func(d ShadowDiff) {
+		fmt.Println(d.Format, "->", d.Candidate, "missing:", d.Missing, "added:", d.Added, "shrunk:", d.SizeDelta() < 0)
+	}))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	_ = ErrQuery.New().WithCode("QUERY_001").Error()
+
+	// Output:
+	// json -> json-v2 missing: [caller] added: [] shrunk: true
+}
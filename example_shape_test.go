@@ -0,0 +1,28 @@
+package errific_test
+
+import (
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleShapes() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+	var ErrHandle Err = "error handling request"
+
+	shapes := NewShapes(16)
+	for i := 0; i < 3; i++ {
+		err := ErrQuery.New().WithCode("QUERY_001").WithContext(map[string]any{"table": "things", "row": i})
+		shapes.Record(ErrHandle.New(err).WithCode("HANDLE_001"))
+		shapes.Record(err)
+	}
+
+	for _, shape := range shapes.Shapes() {
+		fmt.Printf("%s context=%.0f depth=%.0f\n", shape.Code, shape.ContextSize.Mean(), shape.Depth.Mean())
+	}
+
+	// Output:
+	// HANDLE_001 context=2 depth=2
+	// QUERY_001 context=2 depth=1
+}
@@ -0,0 +1,49 @@
+package errific_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+var (
+	ErrQueryStack  Err = "error querying thing"
+	ErrHandleStack Err = "error handling request"
+)
+
+func queryStack() error {
+	return ErrQueryStack.New()
+}
+
+func handleStack() error {
+	return ErrHandleStack.New(queryStack())
+}
+
+func ExampleAppendStacks() {
+	for _, mode := range []Option{InheritStack, CaptureNewStack, AppendStacks} {
+		Configure(WithStack, mode)
+		msg := handleStack().Error()
+		fmt.Println(strings.Contains(msg, "handleStack\n"), strings.Count(msg, "\n  ---"))
+	}
+	Configure()
+
+	// Output:
+	// true 0
+	// false 0
+	// true 1
+}
+
+func ExampleFrames() {
+	Configure(WithStack)
+	defer Configure()
+
+	for _, frame := range Frames(handleStack())[:3] {
+		fmt.Println(frame.Function, strings.HasSuffix(frame.File, "example_stack_test.go"), frame.Line > 0)
+	}
+
+	// Output:
+	// queryStack true true
+	// handleStack true true
+	// ExampleFrames true true
+}
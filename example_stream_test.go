@@ -0,0 +1,87 @@
+package errific_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleStream() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	stream := NewStream(2, DropOldest)
+	all, _ := stream.Subscribe(nil)
+	server, _ := stream.Subscribe(func(info ErrorInfo) bool {
+		return info.Category == CategoryServer
+	})
+
+	stream.Publish(ErrQuery.New().WithCode("QUERY_001").WithCategory(CategoryClient))
+	stream.Publish(ErrQuery.New().WithCode("QUERY_002").WithCategory(CategoryServer))
+	stream.Publish(ErrQuery.New().WithCode("QUERY_003").WithCategory(CategoryServer))
+	stream.Close()
+
+	for info := range all {
+		fmt.Println("all", info.Code)
+	}
+	for info := range server {
+		fmt.Println("server", info.Code)
+	}
+	fmt.Println(stream.Dropped())
+
+	// Output:
+	// all QUERY_002
+	// all QUERY_003
+	// server QUERY_002
+	// server QUERY_003
+	// 1
+}
+
+func ExampleStream_Subscribe() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	stream := NewStream(0, Block)
+	infos, cancel := stream.Subscribe(nil)
+
+	published := make(chan struct{})
+	go func() {
+		stream.Publish(ErrQuery.New().WithCode("QUERY_001"))
+		close(published)
+	}()
+
+	// cancel unblocks the Publish waiting on the subscriber.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	<-published
+	_, ok := <-infos
+	fmt.Println(ok)
+
+	// Output:
+	// false
+}
+
+func ExampleStream_Close() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	stream := NewStream(0, Block)
+	infos, _ := stream.Subscribe(nil)
+
+	published := make(chan struct{})
+	go func() {
+		stream.Publish(ErrQuery.New().WithCode("QUERY_001"))
+		close(published)
+	}()
+
+	// Close unblocks the Publish waiting on the subscriber.
+	time.Sleep(10 * time.Millisecond)
+	stream.Close()
+	<-published
+	_, ok := <-infos
+	fmt.Println(ok)
+
+	// Output:
+	// false
+}
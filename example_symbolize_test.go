@@ -0,0 +1,32 @@
+package errific_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleSymbolize() {
+	Configure(WithStack, PCStack)
+	defer Configure()
+	var ErrRender Err = "error rendering thing"
+
+	render := func() error {
+		return ErrRender.New()
+	}
+
+	err := render()
+	stack := strings.SplitN(err.Error(), "\n", 2)[1]
+	fmt.Println(strings.HasPrefix(stack, "  pc"))
+
+	// resolve the offsets with a build of the binary with symbols.
+	exe, _ := os.Executable()
+	symbolized, _ := Symbolize(stack, exe)
+	fmt.Println(strings.Contains(symbolized, "example_symbolize_test.go:20.ExampleSymbolize"))
+
+	// Output:
+	// true
+	// true
+}
@@ -0,0 +1,29 @@
+package errific_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleOutputSyslog() {
+	Configure(OutputSyslog, NoCapture, ServiceIdentity("things", "v1.2.3", "prod"))
+	defer Configure()
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(CategoryServer).
+		WithCorrelationID("c-123").
+		WithLabel("region", "us-east-1")
+
+	// <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+	fields := strings.SplitN(err.Error(), " ", 7)
+	fmt.Println(fields[0], fields[3], fields[5])
+	fmt.Println(fields[6])
+
+	// Output:
+	// <11>1 things QUERY_001
+	// [errific@32473 code="QUERY_001" category="server" correlation_id="c-123"][labels@32473 region="us-east-1"] error querying thing
+}
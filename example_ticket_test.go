@@ -0,0 +1,29 @@
+package errific_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleTicketer() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	sink := TicketSinkFunc(func(ctx context.Context, t Ticket) error {
+		fmt.Println(t.Title(), t.Count, len(t.Fingerprint))
+		return nil
+	})
+	ticketer := NewTicketer(sink, time.Hour)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		// deduplicated within the hour.
+		_ = ticketer.Report(ctx, ErrQuery.New().WithCode("QUERY_001"))
+	}
+
+	// Output:
+	// [QUERY_001] error querying thing 1 16
+}
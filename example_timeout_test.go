@@ -0,0 +1,27 @@
+package errific_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleWithTimeout() {
+	Configure() // default configuration
+
+	err := WithTimeout(context.Background(), "fetch thing", time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	fmt.Println(errors.Is(err, ErrTimeout), errors.Is(err, context.DeadlineExceeded))
+	fmt.Println(GetCategory(err), IsRetryable(err))
+	fmt.Println(GetContext(err)[ContextTimeoutOperation])
+
+	// Output:
+	// true true
+	// timeout true
+	// fetch thing
+}
@@ -0,0 +1,27 @@
+package errific_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleErr_New_withTrace() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	ctx, task := TraceTask(context.Background(), "processThing")
+	defer task.End()
+
+	var err error
+	TraceRegion(ctx, "query", func(ctx context.Context) {
+		err = ErrQuery.New().WithTrace(ctx)
+	})
+
+	fields := GetContext(err)
+	fmt.Println(fields[ContextTraceTask], fields[ContextTraceRegion])
+
+	// Output:
+	// processThing query
+}
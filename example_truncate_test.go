@@ -0,0 +1,33 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleTruncate() {
+	fmt.Println(Truncate("error querying thing", 12))
+	fmt.Println(Truncate("héllo wörld", 9))
+	fmt.Println(TruncateWidth("エラーが発生しました", 9))
+
+	// Output:
+	// error…hing
+	// hé…rld
+	// エラ…した
+}
+
+func ExampleMaxJSONSize_truncate() {
+	Configure(MaxJSONSize(128))
+	defer Configure()
+	var ErrQuery Err = Err("error querying " + strings.Repeat("日本", 40))
+
+	b, _ := json.Marshal(ErrQuery.New())
+	fmt.Println(len(b) <= 128, utf8.Valid(b), strings.Contains(string(b), Ellipsis))
+
+	// Output:
+	// true true true
+}
@@ -0,0 +1,46 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleGetUpstream() {
+	Configure() // default configuration
+	var ErrCharge Err = "error charging payment"
+
+	err := ErrCharge.New().
+		WithCode("PAYMENT_001").
+		WithUpstream("billing", "BILLING_UNAVAILABLE", http.StatusServiceUnavailable)
+
+	upstream := GetUpstream(err)
+	fmt.Println(GetCode(err), upstream.Service, upstream.Code, upstream.Status)
+
+	b, _ := json.Marshal(ResolveChain(err).Upstream)
+	fmt.Println(string(b))
+
+	// Output:
+	// PAYMENT_001 billing BILLING_UNAVAILABLE 503
+	// {"service":"billing","code":"BILLING_UNAVAILABLE","status":503}
+}
+
+func ExampleGetLogRef() {
+	Configure() // default configuration
+	var ErrCharge Err = "error charging payment"
+
+	err := fmt.Errorf("handling request: %w", ErrCharge.New().
+		WithLogRef("app-logs/2026-10-16", "184467"))
+
+	ref := GetLogRef(err)
+	fmt.Println(ref.Stream, ref.Offset)
+
+	b, _ := json.Marshal(ResolveChain(err).LogRef)
+	fmt.Println(string(b))
+
+	// Output:
+	// app-logs/2026-10-16 184467
+	// {"stream":"app-logs/2026-10-16","offset":"184467"}
+}
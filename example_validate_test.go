@@ -0,0 +1,29 @@
+package errific_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleValidate() {
+	Configure() // default configuration
+	var ErrQuery Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCategory(CategoryServer).
+		WithHTTPStatus(200).
+		WithRetryAfter(time.Second).
+		WithRetryable(false)
+
+	for _, issue := range Validate(err) {
+		fmt.Println(issue)
+	}
+	fmt.Println(len(Validate(ErrQuery.New().WithCategory(CategoryServer).WithHTTPStatus(503))))
+
+	// Output:
+	// retry_after: retry after 1s, but not retryable
+	// http_status: non-error HTTP status 200
+	// 0
+}
@@ -0,0 +1,61 @@
+package errific_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/leefernandes/errific"
+)
+
+func ExampleWriteHTTP() {
+	wd, _ := os.Getwd()
+	Configure(TrimPrefixes(wd + "/"))
+	var ErrQuery Err = "error querying thing"
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCorrelationID("req-123").
+		WithHTTPStatus(http.StatusServiceUnavailable).
+		WithRetryable(true).
+		WithRetryAfter(2 * time.Second).
+		WithContext(map[string]any{"table": "things"})
+
+	w := httptest.NewRecorder()
+	WriteHTTP(w, err)
+	fmt.Println(w.Code, w.Header().Get("Retry-After"))
+	fmt.Print(w.Body.String())
+
+	Configure(TrimPrefixes(wd+"/"), HTTPView(ViewInternal))
+	defer Configure()
+	w = httptest.NewRecorder()
+	WriteHTTP(w, err)
+	fmt.Print(w.Body.String())
+
+	// Output:
+	// 503 2
+	// {"message":"Service Unavailable","code":"QUERY_001","correlation_id":"req-123","retryable":true,"retry_after":2}
+	// {"message":"error querying thing","caller":"example_writehttp_test.go:18.ExampleWriteHTTP","code":"QUERY_001","correlation_id":"req-123","context":{"table":"things"},"http_status":503,"retryable":true,"retry_after":2}
+}
+
+func ExampleEncodeHTTP_retryAfter() {
+	Configure(NoCapture)
+	defer Configure()
+	var ErrBusy Err = "thing service busy"
+	err := ErrBusy.New().WithCategory(CategoryRateLimited).WithRetryAfter(1500 * time.Millisecond)
+
+	_, header, body, _ := EncodeHTTP(err)
+	fmt.Println(header.Get("Retry-After"))
+	fmt.Println(string(body))
+
+	var info ErrorInfo
+	json.Unmarshal(body, &info)
+	fmt.Println(info.RetryAfter)
+
+	// Output:
+	// 2
+	// {"message":"thing service busy","category":"rate_limited","retryable":true,"retry_after":1.5}
+	// 1.5s
+}
@@ -13,16 +13,16 @@ func ExampleWithStack() {
 	var ErrExample Err = "example error"
 
 	err := ErrExample.New()
-	fmt.Println(err)
+	fmt.Println(testmain.ReplaceAllString(err.Error(), "_testmain.go:NN"))
 	fmt.Println(errors.Is(err, ErrExample))
 
 	// Output:
 	// example error [errific/examples/example_withstack_test.go:15.ExampleWithStack]
-	//   _testmain.go:75.main
+	//   _testmain.go:NN.main
 	// true
 }
 
-func ExampleWithStackBubbled() {
+func ExampleWithStack_bubbled() {
 	Configure(WithStack)
 	var ErrRoot Err = "root error"
 	var ErrTop Err = "top error"
@@ -33,13 +33,13 @@ func ExampleWithStackBubbled() {
 	err4 := fmt.Errorf("fmt wrapped 3: %w", err3)
 	err5 := ErrTop.Withf("%w", err4)
 
-	fmt.Println(err5)
+	fmt.Println(testmain.ReplaceAllString(err5.Error(), "_testmain.go:NN"))
 	fmt.Println(errors.Is(err5, ErrRoot))
 
 	// Output:
-	// top error: fmt wrapped 3: dynamic error [errific/examples/example_withstack_test.go:32.ExampleWithStackBubbled]
-	// fmt wrapped 1: root error [errific/examples/example_withstack_test.go:30.ExampleWithStackBubbled]
-	// EOF [errific/examples/example_withstack_test.go:34.ExampleWithStackBubbled]
-	//   _testmain.go:75.main
+	// top error: fmt wrapped 3: dynamic error [errific/examples/example_withstack_test.go:32.ExampleWithStack_bubbled]
+	// fmt wrapped 1: root error [errific/examples/example_withstack_test.go:30.ExampleWithStack_bubbled]
+	// EOF [errific/examples/example_withstack_test.go:34.ExampleWithStack_bubbled]
+	//   _testmain.go:NN.main
 	// true
 }
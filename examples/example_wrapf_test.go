@@ -8,7 +8,7 @@ import (
 	. "github.com/leefernandes/errific"
 )
 
-func ExampleWrapf() {
+func ExampleErr_Wrapf() {
 	Configure() // default configuration
 	// wrap a formatted error.
 	var ErrExample Err = "example error"
@@ -18,13 +18,13 @@ func ExampleWrapf() {
 	fmt.Println(errors.Is(err, io.EOF))
 
 	// Output:
-	// example error [errific/examples/example_wrapf_test.go:15.ExampleWrapf]
+	// example error [errific/examples/example_wrapf_test.go:15.ExampleErr_Wrapf]
 	// formatted 1: EOF
 	// true
 	// true
 }
 
-func ExampleWrapfNest() {
+func ExampleErr_Wrapf_nest() {
 	Configure() // default configuration
 	// wrapped & formatted errific error chain.
 	var (
@@ -40,15 +40,15 @@ func ExampleWrapfNest() {
 	fmt.Println(errors.Is(err2, io.EOF))
 
 	// Output:
-	// error 2 [errific/examples/example_wrapf_test.go:35.ExampleWrapfNest]
-	// format 1: error 1 [errific/examples/example_wrapf_test.go:34.ExampleWrapfNest]
+	// error 2 [errific/examples/example_wrapf_test.go:35.ExampleErr_Wrapf_nest]
+	// format 1: error 1 [errific/examples/example_wrapf_test.go:34.ExampleErr_Wrapf_nest]
 	// format 0: EOF
 	// true
 	// true
 	// true
 }
 
-func ExampleWrapfChain() {
+func ExampleErr_Wrapf_chain() {
 	Configure() // default configuration
 	var ErrExample Err = "example error"
 
@@ -62,7 +62,7 @@ func ExampleWrapfChain() {
 	fmt.Println(errors.Is(err, io.EOF))
 
 	// Output:
-	// example error [errific/examples/example_wrapf_test.go:56.ExampleWrapfChain]
+	// example error [errific/examples/example_wrapf_test.go:56.ExampleErr_Wrapf_chain]
 	// first 1
 	// second 2
 	// third 3
@@ -0,0 +1,7 @@
+package examples
+
+import "regexp"
+
+// testmain matches the line of the generated test main frame,
+// which varies by Go version.
+var testmain = regexp.MustCompile(`_testmain\.go:\d+`)
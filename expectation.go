@@ -0,0 +1,38 @@
+package errific
+
+import "fmt"
+
+// Mismatch is the expected and actual value of a field, see Expectation.
+type Mismatch struct {
+	Field    string `json:"field"`
+	Expected any    `json:"expected"`
+	Actual   any    `json:"actual"`
+}
+
+// Expectation returns an error using e as text, with the field and its
+// expected and actual values formatted inline and recorded as a Mismatch,
+// for validation layers and contract tests. Mismatches of the chain are
+// in the "expectations" JSON section and aligned columns of OutputCI.
+//
+//	var ErrInvalidOrder errific.Err = "invalid order"
+//
+//	return errific.Expectation(ErrInvalidOrder, "currency", "USD", order.Currency)
+func Expectation(e Err, field string, expected, actual any) error {
+	err := e.newAt(1)
+	err.err = fmt.Errorf("%s: %s: expected %v, actual %v", e, field, expected, actual)
+	err.unwrap = append(err.unwrap, e)
+	err.mismatch = &Mismatch{Field: field, Expected: expected, Actual: actual}
+	return err
+}
+
+// GetExpectations returns the Mismatches of the Expectation errors
+// in the err chain, outermost first.
+func GetExpectations(err error) (mismatches []Mismatch) {
+	walk(err, func(e errific) bool {
+		if e.mismatch != nil {
+			mismatches = append(mismatches, *e.mismatch)
+		}
+		return true
+	})
+	return mismatches
+}
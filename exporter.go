@@ -0,0 +1,247 @@
+package errific
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Row is a count of errors with the same code, category,
+// and grouped labels within a time bucket.
+type Row struct {
+	Bucket   time.Time
+	Code     string
+	Category Category
+	// Labels are the values of the grouped label keys, empty if not set.
+	Labels map[string]string
+	Count  uint64
+}
+
+// MarshalJSON encodes r as a flat object with labels as label_<key>
+// columns, so rows load into warehouses as CSV or Parquet.
+func (r Row) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(r.Labels)+4)
+	m["bucket"] = r.Bucket.UTC().Format(time.RFC3339)
+	m["code"] = r.Code
+	m["category"] = r.Category
+	m["count"] = r.Count
+	for k, v := range r.Labels {
+		m["label_"+k] = v
+	}
+	return json.Marshal(m)
+}
+
+// Generalized replaces label values of rows generalized by Anonymize.
+const Generalized = "*"
+
+// Sink receives flushed rows as newline delimited JSON,
+// for example to upload them to object storage.
+type Sink func(ctx context.Context, rows []byte) error
+
+// WriterSink returns a Sink writing rows to w.
+func WriterSink(w io.Writer) Sink {
+	return func(ctx context.Context, rows []byte) error {
+		_, err := w.Write(rows)
+		return err
+	}
+}
+
+// Exporter aggregates error counts by code, category, and labels over
+// time buckets and flushes them as Rows to a Sink, feeding analytics
+// dashboards without an APM vendor.
+// An Exporter is safe for concurrent use.
+//
+//	exporter := errific.NewExporter(errific.WriterSink(f), time.Minute, "region")
+//	go exporter.Run(ctx, 5*time.Minute, nil)
+//
+//	exporter.Record(err)
+type Exporter struct {
+	sink   Sink
+	bucket time.Duration
+	labels []string
+
+	mu   sync.Mutex
+	k    uint64
+	rows map[string]*Row
+}
+
+// NewExporter returns an Exporter flushing to sink, counting errors
+// in buckets of duration bucket grouped by the label keys labels.
+// Buckets default to one minute.
+func NewExporter(sink Sink, bucket time.Duration, labels ...string) *Exporter {
+	if bucket <= 0 {
+		bucket = time.Minute
+	}
+	return &Exporter{
+		sink:   sink,
+		bucket: bucket,
+		labels: labels,
+		rows:   map[string]*Row{},
+	}
+}
+
+// Record counts err in the current bucket. Nil errors are ignored.
+func (x *Exporter) Record(err error) {
+	if err == nil {
+		return
+	}
+
+	r := Row{
+		Bucket:   time.Now().Truncate(x.bucket),
+		Code:     GetCode(err),
+		Category: GetCategory(err),
+	}
+
+	key := []string{r.Bucket.String(), r.Code, string(r.Category)}
+	if len(x.labels) > 0 {
+		labels := GetLabels(err)
+		r.Labels = make(map[string]string, len(x.labels))
+		for _, k := range x.labels {
+			r.Labels[k] = labels[k]
+			key = append(key, labels[k])
+		}
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	k := strings.Join(key, "\x00")
+	row, ok := x.rows[k]
+	if !ok {
+		row = &r
+		x.rows[k] = row
+	}
+	row.Count++
+}
+
+// Anonymize rows flushed with grouped labels to k-anonymity, so
+// error analytics can be shared without exposing the activity of
+// individual tenants or users. Rows counting fewer than k errors have
+// their label values generalized to Generalized and are merged with
+// the other generalized rows of their bucket, code, and category.
+// Generalized rows still counting fewer than k errors are suppressed.
+//
+//	exporter := errific.NewExporter(sink, time.Hour, "tenant", "user")
+//	exporter.Anonymize(10)
+func (x *Exporter) Anonymize(k int) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.k = uint64(max(k, 0))
+}
+
+// Flush writes the aggregated rows, oldest bucket first, to the sink
+// and resets the counts. Rows are kept when the sink fails.
+func (x *Exporter) Flush(ctx context.Context) error {
+	x.mu.Lock()
+	rows := x.rows
+	x.rows = map[string]*Row{}
+	k := x.k
+	x.mu.Unlock()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	sorted := x.anonymize(rows, k)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].Bucket.Equal(sorted[j].Bucket) {
+			return sorted[i].Bucket.Before(sorted[j].Bucket)
+		}
+		return sorted[i].Count > sorted[j].Count
+	})
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, row := range sorted {
+		if err := enc.Encode(row); err != nil {
+			x.restore(rows)
+			return err
+		}
+	}
+
+	if err := x.sink(ctx, buf.Bytes()); err != nil {
+		x.restore(rows)
+		return err
+	}
+	return nil
+}
+
+// anonymize returns rows generalized and suppressed to k-anonymity.
+// rows are not modified, so they can be restored.
+func (x *Exporter) anonymize(rows map[string]*Row, k uint64) []*Row {
+	anonymous := make([]*Row, 0, len(rows))
+	if k <= 1 || len(x.labels) == 0 {
+		for _, row := range rows {
+			anonymous = append(anonymous, row)
+		}
+		return anonymous
+	}
+
+	generalized := map[string]*Row{}
+	for _, row := range rows {
+		if row.Count >= k {
+			anonymous = append(anonymous, row)
+			continue
+		}
+
+		key := strings.Join([]string{row.Bucket.String(), row.Code, string(row.Category)}, "\x00")
+		g, ok := generalized[key]
+		if !ok {
+			g = &Row{Bucket: row.Bucket, Code: row.Code, Category: row.Category, Labels: make(map[string]string, len(x.labels))}
+			for _, l := range x.labels {
+				g.Labels[l] = Generalized
+			}
+			generalized[key] = g
+		}
+		g.Count += row.Count
+	}
+
+	for _, g := range generalized {
+		if g.Count >= k {
+			anonymous = append(anonymous, g)
+		}
+	}
+	return anonymous
+}
+
+// restore merges rows that failed to flush back into the counts.
+func (x *Exporter) restore(rows map[string]*Row) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for k, row := range rows {
+		if current, ok := x.rows[k]; ok {
+			current.Count += row.Count
+			continue
+		}
+		x.rows[k] = row
+	}
+}
+
+// Run calls Flush every interval until ctx is done, then flushes
+// once more with a background context. Flush errors are passed to
+// onError, if not nil.
+func (x *Exporter) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	flush := func(ctx context.Context) {
+		if err := x.Flush(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			flush(context.Background())
+			return
+		case <-ticker.C:
+			flush(ctx)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package fiber_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	fiberv2 "github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/fiber"
+)
+
+func ExampleErrorHandler() {
+	errific.Configure() // default configuration
+	var ErrNotFound errific.Err = "thing not found"
+
+	app := fiberv2.New(fiberv2.Config{ErrorHandler: fiber.ErrorHandler})
+	app.Use(requestid.New(requestid.Config{Generator: func() string { return "req-123" }}))
+	app.Get("/things/:id", func(c *fiberv2.Ctx) error {
+		return ErrNotFound.New().WithCode("THING_404").WithCategory(errific.CategoryNotFound)
+	})
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/things/1", nil))
+	body, _ := io.ReadAll(resp.Body)
+
+	fmt.Println(resp.StatusCode, resp.Header.Get(errific.HeaderCorrelationID))
+	fmt.Print(string(body))
+
+	// Output:
+	// 404 req-123
+	// {"message":"thing not found","code":"THING_404","category":"not_found"}
+}
@@ -0,0 +1,57 @@
+// Package fiber writes errific errors of Fiber handlers as responses.
+//
+// It is a separate module so errific does not depend on Fiber.
+//
+//	app := fiberv2.New(fiberv2.Config{ErrorHandler: fiber.ErrorHandler})
+package fiber
+
+import (
+	"errors"
+	"strings"
+
+	fiberv2 "github.com/gofiber/fiber/v2"
+	"github.com/leefernandes/errific"
+)
+
+// RequestIDKey is the context locals key of the request ID,
+// as set by the Fiber requestid middleware.
+var RequestIDKey = "requestid"
+
+// ErrorHandler is a fiber.ErrorHandler writing err, after converting it
+// with Convert, with the status, headers, and body of errific.EncodeHTTP.
+// Errors without a correlation ID have the request ID of the context
+// locals at RequestIDKey as X-Errific-Correlation-Id header.
+func ErrorHandler(c *fiberv2.Ctx, err error) error {
+	err = Convert(err)
+	errific.AddError(c.UserContext(), err)
+
+	status, header, body, marshalErr := errific.EncodeHTTP(err)
+	if marshalErr != nil {
+		return c.SendStatus(fiberv2.StatusInternalServerError)
+	}
+
+	for k, v := range header {
+		c.Set(k, strings.Join(v, ", "))
+	}
+	if errific.GetCorrelationID(err) == "" {
+		if id, ok := c.Locals(RequestIDKey).(string); ok && id != "" {
+			c.Set(errific.HeaderCorrelationID, id)
+		}
+	}
+	return c.Status(status).Send(append(body, '\n'))
+}
+
+// Convert returns err, or for errors with a fiber.Error and no errific
+// error in their chain, an errific error with the message and status
+// of the fiber.Error.
+func Convert(err error) error {
+	if _, ok := errific.Info(err); ok {
+		return err
+	}
+
+	var fe *fiberv2.Error
+	if !errors.As(err, &fe) {
+		return err
+	}
+	return errific.Compose(fe.Message, errific.ComposeInfo(errific.ErrorInfo{HTTPStatus: fe.Code}))
+}
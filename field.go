@@ -0,0 +1,185 @@
+package errific
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Codec configures the serialization, visibility, and integration
+// mapping of a custom field registered with RegisterField.
+type Codec[T any] struct {
+	// Encode returns the JSON value of v, v itself if nil.
+	Encode func(v T) any
+	// Decode returns the value of a decoded JSON value,
+	// decoded with encoding/json if nil.
+	Decode func(v any) (T, error)
+	// Label returns the value of v as a label of the field name,
+	// for integrations mapping labels, if not nil. Labels set
+	// on errors take precedence.
+	Label func(v T) string
+	// Hidden fields are omitted from ErrorInfo and output, see SetHidden.
+	Hidden bool
+}
+
+// CustomField is a metadata field defined by a package with RegisterField.
+// A CustomField is safe for concurrent use.
+type CustomField[T any] struct {
+	name   string
+	codec  Codec[T]
+	hidden atomic.Bool
+}
+
+// FieldValue is the value of a CustomField, set with WithFields.
+type FieldValue struct {
+	name  string
+	value any
+}
+
+// customField is the untyped view of a CustomField for resolving ErrorInfo.
+type customField interface {
+	encode(v any) any
+	label(v any) (string, bool)
+	isHidden() bool
+}
+
+var customFields = struct {
+	sync.RWMutex
+	registered map[string]customField
+}{registered: map[string]customField{}}
+
+// RegisterField registers a metadata field name of type T, for packages
+// defining their own fields instead of using context. Values are set with
+// WithFields and resolved from the error chain like other fields, with
+// Resolve(Innermost, Field(name)) configuring their precedence.
+// Visible fields are in the "fields" of ErrorInfo and output.
+// Registering a name again replaces it.
+//
+//	var Priority = errific.RegisterField("priority", errific.Codec[int]{
+//		Label: strconv.Itoa,
+//	})
+//
+//	return ErrProcessThing.New(err).WithFields(Priority.Value(1))
+func RegisterField[T any](name string, codec Codec[T]) *CustomField[T] {
+	f := &CustomField[T]{name: name, codec: codec}
+	f.hidden.Store(codec.Hidden)
+
+	customFields.Lock()
+	defer customFields.Unlock()
+	customFields.registered[name] = f
+	return f
+}
+
+// Name returns the name of f.
+func (f *CustomField[T]) Name() string {
+	return f.name
+}
+
+// Value returns v as the value of f, for WithFields.
+func (f *CustomField[T]) Value(v T) FieldValue {
+	return FieldValue{name: f.name, value: v}
+}
+
+// Get returns the value of f set in the err chain.
+func (f *CustomField[T]) Get(err error) (v T, ok bool) {
+	resolve(err, Field(f.name), func(e errific) bool {
+		var value any
+		if value, ok = e.fields[f.name]; ok {
+			v, ok = value.(T)
+		}
+		return !ok
+	})
+	return v, ok
+}
+
+// Decode returns the value of f in info, such as decoded with FromJSON.
+func (f *CustomField[T]) Decode(info ErrorInfo) (v T, ok bool, err error) {
+	value, ok := info.Fields[f.name]
+	if !ok {
+		return v, false, nil
+	}
+	if typed, isT := value.(T); isT {
+		return typed, true, nil
+	}
+	if f.codec.Decode != nil {
+		v, err = f.codec.Decode(value)
+		return v, err == nil, err
+	}
+
+	b, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(b, &v)
+	}
+	if err != nil {
+		return v, false, fmt.Errorf("decoding field %s: %w", f.name, err)
+	}
+	return v, true, nil
+}
+
+// SetHidden toggles whether f is omitted from ErrorInfo and output.
+// Get resolves hidden fields.
+func (f *CustomField[T]) SetHidden(hidden bool) {
+	f.hidden.Store(hidden)
+}
+
+func (f *CustomField[T]) encode(v any) any {
+	if typed, ok := v.(T); ok && f.codec.Encode != nil {
+		return f.codec.Encode(typed)
+	}
+	return v
+}
+
+func (f *CustomField[T]) label(v any) (string, bool) {
+	typed, ok := v.(T)
+	if !ok || f.codec.Label == nil {
+		return "", false
+	}
+	return f.codec.Label(typed), true
+}
+
+func (f *CustomField[T]) isHidden() bool {
+	return f.hidden.Load()
+}
+
+// WithFields sets the values of custom fields registered with RegisterField.
+//
+//	return ErrProcessThing.New(err).WithFields(Priority.Value(1), Owner.Value("payments"))
+func (e errific) WithFields(values ...FieldValue) errific {
+	merged := make(map[string]any, len(e.fields)+len(values))
+	for k, v := range e.fields {
+		merged[k] = v
+	}
+	for _, v := range values {
+		merged[v.name] = v.value
+	}
+	e.fields = merged
+	return e
+}
+
+// encodeFields sets the visible values of resolved custom fields in info,
+// and their labels when not already set.
+func encodeFields(info *ErrorInfo, values map[string]any) {
+	customFields.RLock()
+	defer customFields.RUnlock()
+
+	for name, v := range values {
+		f, ok := customFields.registered[name]
+		if !ok || f.isHidden() {
+			continue
+		}
+		if info.Fields == nil {
+			info.Fields = make(map[string]any, len(values))
+		}
+		info.Fields[name] = f.encode(v)
+
+		if label, ok := f.label(v); ok {
+			if _, set := info.Labels[name]; !set {
+				if info.Labels == nil {
+					info.Labels = map[string]string{}
+				}
+				info.Labels[name] = label
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package errific
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Fingerprint returns a stable hash identifying errors created at the same
+// callers with the same codes and Err texts, regardless of formatted
+// arguments or other metadata. Errors other than errific are identified
+// by their type. Callers are normalized to the package import path, file
+// name, line, and function name, so fingerprints are the same across build
+// machines, checkout directories, container images, -trimpath builds,
+// TrimPrefixes, and CallerFormat.
+//
+//	fmt.Println(errific.Fingerprint(err)) // 5f2b8d1c0e9a4b7d
+func Fingerprint(err error) string {
+	if err == nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(strings.Join(FingerprintParts(err), "\n")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// FingerprintParts returns the inputs hashed by Fingerprint, outermost
+// first, as normalized caller|code|Err text, for debugging why
+// fingerprints of errors differ.
+//
+//	fmt.Println(errific.FingerprintParts(err)) // [example.com/things/thing.go:12.processThing|THING_001|error processing thing]
+func FingerprintParts(err error) []string {
+	var parts []string
+	walk(err, func(e errific) bool {
+		parts = append(parts, site(e)+"|"+e.code+"|"+template(e))
+		return true
+	})
+	if len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%T", err))
+	}
+	return parts
+}
+
+// template returns the Err text of e, without formatted arguments.
+func template(e errific) string {
+	if text, ok := errText(e.err); ok {
+		return string(text)
+	}
+	for _, err := range e.unwrap {
+		if text, ok := errText(err); ok {
+			return string(text)
+		}
+	}
+	return fmt.Sprintf("%T", e.err)
+}
+
+// site returns the caller of e normalized for Fingerprint as
+// package/file.go:line.function, where package is the import path of the
+// function, and function is its name within the package. Callers set with
+// ComposeCaller or decoded from JSON are normalized with normalizeCaller.
+func site(e errific) string {
+	if e.pc == 0 {
+		return normalizeCaller(e.caller)
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{e.pc}).Next()
+
+	pkg, fn := "", frame.Function
+	slash := strings.LastIndex(fn, "/")
+	if dot := strings.Index(fn[slash+1:], "."); dot >= 0 {
+		pkg, fn = fn[:slash+1+dot], fn[slash+1+dot+1:]
+	}
+	return pkg + "/" + path.Base(filepath.ToSlash(frame.File)) + ":" + strconv.Itoa(frame.Line) + "." + fn
+}
+
+// normalizeCaller returns caller as dir/file.go:line.function, without the
+// outer directories of its file, which vary with TrimPrefixes, module roots,
+// and build paths, and with the function in the ShortFunc format.
+func normalizeCaller(caller string) string {
+	i := strings.LastIndex(caller, ".go:")
+	if i < 0 {
+		return caller
+	}
+	file, rest := caller[:i+len(".go")], caller[i+len(".go:"):]
+
+	if j := strings.LastIndex(file, "/"); j >= 0 {
+		if k := strings.LastIndex(file[:j], "/"); k >= 0 {
+			file = file[k+1:]
+		}
+	}
+	if j := strings.Index(rest, "."); j >= 0 {
+		line, fn := rest[:j], rest[j+1:]
+		rest = line + "." + fn[strings.LastIndex(fn, ".")+1:]
+	}
+	return file + ":" + rest
+}
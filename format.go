@@ -0,0 +1,93 @@
+package errific
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+var formats = struct {
+	sync.RWMutex
+	marshal map[string]func(ErrorInfo) ([]byte, error)
+}{
+	marshal: map[string]func(ErrorInfo) ([]byte, error){
+		"json": func(info ErrorInfo) ([]byte, error) {
+			b, err := json.Marshal(info)
+			if err != nil {
+				return json.Marshal(serializable(info))
+			}
+			return b, nil
+		},
+	},
+}
+
+// RegisterFormat registers marshal as the output format name,
+// for use with OutputNamed. Registering a name again replaces it.
+// marshal is passed the ResolveChain of errors, with the messages of
+// wrapped errors joined with ↩ in Message, as with the Inline Layout.
+// The "json" format is registered by default.
+//
+//	errific.RegisterFormat("ltsv", func(info errific.ErrorInfo) ([]byte, error) {
+//		return []byte("message:" + info.Message + "\tcode:" + info.Code), nil
+//	})
+func RegisterFormat(name string, marshal func(ErrorInfo) ([]byte, error)) {
+	formats.Lock()
+	defer formats.Unlock()
+	formats.marshal[name] = marshal
+}
+
+type outputOption string
+
+func (outputOption) ErrificOption() {}
+
+var (
+	// OutputNamed formats Error() output with the format registered as name.
+	// Unregistered formats, and formats failing to marshal,
+	// fall back to the default text output.
+	//
+	//	errific.Configure(errific.OutputNamed("json"))
+	OutputNamed = func(name string) outputOption {
+		return outputOption(name)
+	}
+)
+
+// format returns the Error() output of e in the configured output format.
+func format(e errific) (string, bool) {
+	if c.output == "" {
+		return "", false
+	}
+
+	formats.RLock()
+	marshal, ok := formats.marshal[string(c.output)]
+	formats.RUnlock()
+	if !ok {
+		return "", false
+	}
+
+	b, err := marshal(formatInfo(e))
+	if err != nil {
+		return "", false
+	}
+	return mask(string(b)), true
+}
+
+// formatInfo returns the ResolveChain of e with the messages of the errors
+// e wraps in Message, so output formats keep the root cause.
+func formatInfo(e errific) ErrorInfo {
+	info := ResolveChain(e)
+	info.Message = wrappedMessage(e)
+	return info
+}
+
+// wrappedMessage returns the message of e and of the errors it wraps,
+// without callers or stacks, joined with ↩ to keep formats on one line.
+func wrappedMessage(e errific) string {
+	msg := messageOf(e)
+	for _, err := range e.errs {
+		if w, ok := err.(errific); ok {
+			msg += " ↩ " + wrappedMessage(w)
+			continue
+		}
+		msg += " ↩ " + mask(err.Error())
+	}
+	return msg
+}
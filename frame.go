@@ -0,0 +1,66 @@
+package errific
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Frame is a caller or stack frame of an error.
+type Frame struct {
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Function string `json:"function,omitempty"`
+}
+
+// ParseFrame parses a caller or stack frame formatted as file:line.function.
+// Frames without a line are returned with only the file.
+//
+//	frame := errific.ParseFrame(errific.ResolveChain(err).Caller)
+func ParseFrame(s string) Frame {
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return Frame{File: s}
+	}
+
+	line, function, _ := strings.Cut(s[i+1:], ".")
+	n, err := strconv.Atoi(line)
+	if err != nil {
+		return Frame{File: s}
+	}
+	return Frame{File: s[:i], Line: n, Function: function}
+}
+
+// Frames returns the caller and stack frames, innermost call first, of the
+// error in the err chain that captured the stack of the outermost error with
+// a stack, as wrapping errors inherit stacks with InheritStack. Errors without
+// a stack, captured with the WithStack option, have only the caller of the
+// outermost errific error. Program counter frames of PCStack are skipped.
+//
+//	for _, frame := range errific.Frames(err) {
+//		fmt.Println(frame.Function, frame.File, frame.Line)
+//	}
+func Frames(err error) []Frame {
+	_, caller := outermost(err)
+	stack := GetStack(err)
+	if stack != "" {
+		walk(err, func(e errific) bool {
+			if string(e.stack) == stack {
+				caller = e.caller
+			}
+			return true
+		})
+	}
+
+	var frames []Frame
+	if caller != "" {
+		frames = append(frames, ParseFrame(caller))
+	}
+	for _, line := range strings.Split(stack, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line == strings.TrimSpace(stackSeparator) || strings.HasPrefix(line, "pc") {
+			continue
+		}
+		frames = append(frames, ParseFrame(line))
+	}
+	return frames
+}
@@ -0,0 +1,190 @@
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// ContextUserID is the context key of the ID of the user affected by
+// an error, as errific errors have no user field.
+const ContextUserID = "user_id"
+
+// ErrorEvent is a ReportedErrorEvent of the Error Reporting API.
+type ErrorEvent struct {
+	EventTime      time.Time      `json:"eventTime"`
+	ServiceContext ServiceContext `json:"serviceContext"`
+	Message        string         `json:"message"`
+	Context        *ErrorContext  `json:"context,omitempty"`
+}
+
+// ErrorContext is the HTTP request, user, and report location of an ErrorEvent.
+type ErrorContext struct {
+	HTTPRequest    *HTTPRequestContext `json:"httpRequest,omitempty"`
+	User           string              `json:"user,omitempty"`
+	ReportLocation *ReportLocation     `json:"reportLocation,omitempty"`
+}
+
+// HTTPRequestContext is the HTTP request an error occurred handling.
+type HTTPRequestContext struct {
+	Method             string `json:"method,omitempty"`
+	URL                string `json:"url,omitempty"`
+	UserAgent          string `json:"userAgent,omitempty"`
+	Referrer           string `json:"referrer,omitempty"`
+	ResponseStatusCode int    `json:"responseStatusCode,omitempty"`
+	RemoteIP           string `json:"remoteIp,omitempty"`
+}
+
+// ReportLocation is the source location an error was reported at.
+type ReportLocation struct {
+	FilePath     string `json:"filePath"`
+	LineNumber   int    `json:"lineNumber"`
+	FunctionName string `json:"functionName"`
+}
+
+// NewErrorEvent returns the ErrorEvent of err, in the HTTP request r if not nil.
+//
+// The message is Message of err, the service context the
+// errific.ServiceIdentity, the report location the caller of err, and the
+// user the ContextUserID of its context. Without r, the HTTP request is
+// from the context set by errific.Recover. The response status is the
+// HTTP status of err.
+//
+//	event := gcp.NewErrorEvent(err, r)
+func NewErrorEvent(err error, r *http.Request) ErrorEvent {
+	info := errific.ResolveChain(err)
+	e := ErrorEvent{
+		EventTime:      time.Now().UTC(),
+		ServiceContext: ServiceContext{Service: "errific"},
+		Message:        Message(err),
+	}
+	if s := info.Service; s != nil {
+		e.ServiceContext = ServiceContext{Service: s.Name, Version: s.Version}
+	}
+
+	ctx := &ErrorContext{}
+	if info.Caller != "" {
+		frame := errific.ParseFrame(info.Caller)
+		ctx.ReportLocation = &ReportLocation{
+			FilePath:     frame.File,
+			LineNumber:   frame.Line,
+			FunctionName: frame.Function,
+		}
+	}
+	if user, ok := info.Context[ContextUserID].(string); ok {
+		ctx.User = user
+	}
+
+	var req HTTPRequestContext
+	if r != nil {
+		req = HTTPRequestContext{
+			Method:    r.Method,
+			URL:       r.URL.String(),
+			UserAgent: r.UserAgent(),
+			Referrer:  r.Referer(),
+			RemoteIP:  r.RemoteAddr,
+		}
+	} else {
+		req.Method, _ = info.Context[errific.ContextMethod].(string)
+		req.URL, _ = info.Context[errific.ContextPath].(string)
+		req.RemoteIP, _ = info.Context[errific.ContextRemoteAddr].(string)
+	}
+	req.ResponseStatusCode = info.HTTPStatus
+	if req != (HTTPRequestContext{}) {
+		ctx.HTTPRequest = &req
+	}
+
+	if *ctx != (ErrorContext{}) {
+		e.Context = ctx
+	}
+	return e
+}
+
+// Message returns the message of err with its errific.Frames formatted
+// as a Go stack trace, which Error Reporting parses to group errors.
+// The stack formatted by the WithStack option is replaced.
+func Message(err error) string {
+	msg := err.Error()
+	if stack := errific.GetStack(err); stack != "" {
+		msg = strings.Replace(msg, stack, "", 1)
+	}
+
+	frames := errific.Frames(err)
+	if len(frames) == 0 {
+		return msg
+	}
+
+	var b strings.Builder
+	b.WriteString(msg)
+	b.WriteString("\n\ngoroutine 1 [running]:")
+	for _, f := range frames {
+		fmt.Fprintf(&b, "\n%s()\n\t%s:%d", f.Function, f.File, f.Line)
+	}
+	return b.String()
+}
+
+// Client reports errors to the Error Reporting API.
+//
+//	client := gcp.NewClient("my-project", oauthClient)
+//
+//	err := client.Report(ctx, gcp.NewErrorEvent(err, r))
+type Client struct {
+	// ProjectID of the Google Cloud project.
+	ProjectID string
+	// APIKey authenticates requests if set, otherwise HTTPClient must.
+	APIKey string
+	// HTTPClient sends requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+	// Endpoint of the API, https://clouderrorreporting.googleapis.com if empty.
+	Endpoint string
+}
+
+// NewClient returns a Client reporting to projectID with httpClient,
+// authorized for the Error Reporting API.
+func NewClient(projectID string, httpClient *http.Client) *Client {
+	return &Client{ProjectID: projectID, HTTPClient: httpClient}
+}
+
+// Report reports event.
+func (c *Client) Report(ctx context.Context, event ErrorEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = "https://clouderrorreporting.googleapis.com"
+	}
+	u := endpoint + "/v1beta1/projects/" + url.PathEscape(c.ProjectID) + "/events:report"
+	if c.APIKey != "" {
+		u += "?key=" + url.QueryEscape(c.APIKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("gcp: report %s: %s", c.ProjectID, resp.Status)
+	}
+	return nil
+}
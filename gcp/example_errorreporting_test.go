@@ -0,0 +1,59 @@
+package gcp_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/gcp"
+)
+
+func ExampleNewErrorEvent() {
+	errific.Configure(errific.ServiceIdentity("things", "v1.2.3", "prod"))
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	r := httptest.NewRequest(http.MethodGet, "/things/42", nil)
+	err := ErrQuery.New(io.EOF).
+		WithHTTPStatus(http.StatusBadGateway).
+		WithContext(map[string]any{gcp.ContextUserID: "u-42"})
+
+	e := gcp.NewErrorEvent(err, r)
+	fmt.Println(e.ServiceContext.Service, e.ServiceContext.Version, e.Context.User)
+	fmt.Println(e.Context.HTTPRequest.Method, e.Context.HTTPRequest.URL, e.Context.HTTPRequest.ResponseStatusCode)
+	fmt.Println(e.Context.ReportLocation.FunctionName)
+
+	lines := strings.Split(e.Message, "\n")
+	fmt.Println(lines[3:5])
+
+	// Output:
+	// things v1.2.3 u-42
+	// GET /things/42 502
+	// ExampleNewErrorEvent
+	// [goroutine 1 [running]: ExampleNewErrorEvent()]
+}
+
+func ExampleClient_Report() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e gcp.ErrorEvent
+		json.NewDecoder(r.Body).Decode(&e)
+		fmt.Println(r.URL.Path, r.URL.Query().Get("key"), e.ServiceContext.Service)
+	}))
+	defer server.Close()
+
+	client := gcp.NewClient("my-project", nil)
+	client.APIKey = "api-key"
+	client.Endpoint = server.URL
+
+	var ErrQuery errific.Err = "error querying thing"
+	fmt.Println(client.Report(context.Background(), gcp.NewErrorEvent(ErrQuery.New(), nil)))
+
+	// Output:
+	// /v1beta1/projects/my-project/events:report api-key errific
+	// <nil>
+}
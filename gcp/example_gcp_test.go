@@ -0,0 +1,31 @@
+package gcp_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/gcp"
+)
+
+func ExampleNewEntry() {
+	errific.Configure(errific.ServiceIdentity("things", "v1.2.3", "prod"))
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCategory(errific.CategoryServer).
+		WithCorrelationID("4bf92f3577b34da6a3ce929d0e0e4736")
+
+	e := gcp.NewEntry(err, "my-project")
+	fmt.Println(e.Severity, e.Type == gcp.ReportedErrorEvent)
+	fmt.Println(e.Trace)
+	fmt.Println(strings.HasSuffix(e.SourceLocation.File, "example_gcp_test.go"), e.SourceLocation.Line, e.SourceLocation.Function)
+	fmt.Println(e.ServiceContext.Service, e.ServiceContext.Version)
+
+	// Output:
+	// ERROR true
+	// projects/my-project/traces/4bf92f3577b34da6a3ce929d0e0e4736
+	// true 16 ExampleNewEntry
+	// things v1.2.3
+}
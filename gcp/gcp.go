@@ -0,0 +1,91 @@
+// Package gcp formats errific errors as Google Cloud Logging structured
+// entries, which Error Reporting ingests, and reports them to the
+// Error Reporting API.
+package gcp
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/leefernandes/errific"
+)
+
+// ReportedErrorEvent is the @type marking entries for Error Reporting.
+const ReportedErrorEvent = "type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent"
+
+// Entry is a Cloud Logging structured log entry of an error.
+type Entry struct {
+	Severity       string            `json:"severity"`
+	Message        string            `json:"message"`
+	Type           string            `json:"@type"`
+	Trace          string            `json:"logging.googleapis.com/trace,omitempty"`
+	SourceLocation *SourceLocation   `json:"logging.googleapis.com/sourceLocation,omitempty"`
+	Labels         map[string]string `json:"logging.googleapis.com/labels,omitempty"`
+	ServiceContext *ServiceContext   `json:"serviceContext,omitempty"`
+	Errific        errific.ErrorInfo `json:"errific"`
+}
+
+// SourceLocation is the source location of an entry.
+type SourceLocation struct {
+	File     string `json:"file"`
+	Line     string `json:"line,omitempty"`
+	Function string `json:"function,omitempty"`
+}
+
+// ServiceContext identifies the service of an error for Error Reporting.
+type ServiceContext struct {
+	Service string `json:"service"`
+	Version string `json:"version,omitempty"`
+}
+
+// NewEntry returns the structured Entry of err in projectID.
+//
+// Severity is mapped from errific.LogLevel. The trace is the correlation
+// ID of err as a trace of projectID, the source location is its caller,
+// and the service context is the errific.ServiceIdentity. The message
+// is Message of err, with a Go stack trace Error Reporting groups errors by.
+//
+//	json.NewEncoder(os.Stderr).Encode(gcp.NewEntry(err, "my-project"))
+func NewEntry(err error, projectID string) Entry {
+	info := errific.ResolveChain(err)
+	e := Entry{
+		Severity: Severity(errific.LogLevel(err)),
+		Message:  Message(err),
+		Type:     ReportedErrorEvent,
+		Labels:   info.Labels,
+		Errific:  info,
+	}
+
+	if info.CorrelationID != "" && projectID != "" {
+		e.Trace = "projects/" + projectID + "/traces/" + info.CorrelationID
+	}
+	if info.Caller != "" {
+		frame := errific.ParseFrame(info.Caller)
+		e.SourceLocation = &SourceLocation{File: frame.File, Function: frame.Function}
+		if frame.Line != 0 {
+			e.SourceLocation.Line = strconv.Itoa(frame.Line)
+		}
+	}
+	if s := info.Service; s != nil {
+		e.ServiceContext = &ServiceContext{Service: s.Name, Version: s.Version}
+	}
+
+	return e
+}
+
+// Severity returns the Cloud Logging severity of level:
+// CRITICAL, ERROR, WARNING, INFO, or DEBUG.
+func Severity(level slog.Level) string {
+	switch {
+	case level >= errific.LevelCritical:
+		return "CRITICAL"
+	case level >= slog.LevelError:
+		return "ERROR"
+	case level >= slog.LevelWarn:
+		return "WARNING"
+	case level >= slog.LevelInfo:
+		return "INFO"
+	default:
+		return "DEBUG"
+	}
+}
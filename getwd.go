@@ -0,0 +1,9 @@
+//go:build !js && !wasip1
+
+package errific
+
+import "os"
+
+func getwd() (string, error) {
+	return os.Getwd()
+}
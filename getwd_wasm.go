@@ -0,0 +1,9 @@
+//go:build js || wasip1
+
+package errific
+
+// getwd returns no directory on js/wasm and wasip1,
+// where there is no meaningful working directory, so TrimCWD is a no-op.
+func getwd() (string, error) {
+	return "", nil
+}
@@ -0,0 +1,33 @@
+package gin_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	gingonic "github.com/gin-gonic/gin"
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/gin"
+)
+
+func ExampleMiddleware() {
+	errific.Configure() // default configuration
+	gingonic.SetMode(gingonic.TestMode)
+	var ErrNotFound errific.Err = "thing not found"
+
+	r := gingonic.New()
+	r.Use(gin.Middleware())
+	r.GET("/things/:id", func(c *gingonic.Context) {
+		c.Error(ErrNotFound.New().WithCode("THING_404").WithCategory(errific.CategoryNotFound))
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/things/1", nil))
+
+	fmt.Println(w.Code, w.Header().Get(errific.HeaderCode))
+	fmt.Print(w.Body.String())
+
+	// Output:
+	// 404 THING_404
+	// {"message":"thing not found","code":"THING_404","category":"not_found"}
+}
@@ -0,0 +1,73 @@
+// Package gin writes errific errors of Gin handlers as responses.
+//
+// It is a separate module so errific does not depend on Gin.
+//
+//	r := gingonic.New()
+//	r.Use(gin.Middleware())
+//	r.GET("/things/:id", func(c *gingonic.Context) {
+//		thing, err := getThing(c, c.Param("id"))
+//		if err != nil {
+//			gin.Abort(c, err)
+//			return
+//		}
+//		c.JSON(http.StatusOK, thing)
+//	})
+package gin
+
+import (
+	gingonic "github.com/gin-gonic/gin"
+	"github.com/leefernandes/errific"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware returns a Gin middleware handling the errors of c.Errors
+// once the handlers of the request return. Each error is recorded on
+// the active span of the request, if any, with its errific code and
+// category as event attributes, and reported with
+// errific.AddError, and unless a response was written, the last error
+// is written with errific.WriteHTTP.
+func Middleware() gingonic.HandlerFunc {
+	return func(c *gingonic.Context) {
+		c.Next()
+		if len(c.Errors) == 0 {
+			return
+		}
+
+		ctx := c.Request.Context()
+		span := trace.SpanFromContext(ctx)
+		for _, e := range c.Errors {
+			if span.IsRecording() {
+				span.RecordError(e.Err, trace.WithAttributes(
+					attribute.String("errific.code", errific.GetCode(e.Err)),
+					attribute.String("errific.category", string(errific.GetCategory(e.Err))),
+				))
+			}
+			errific.AddError(ctx, e.Err)
+		}
+
+		if !c.Writer.Written() {
+			errific.WriteHTTP(c.Writer, c.Errors.Last().Err)
+		}
+	}
+}
+
+// Abort adds err to c.Errors, writes it with errific.WriteHTTP,
+// and aborts the handlers of the request.
+//
+//	if err != nil {
+//		gin.Abort(c, err)
+//		return
+//	}
+func Abort(c *gingonic.Context, err error) {
+	c.Error(err)
+	errific.WriteHTTP(c.Writer, err)
+	c.Abort()
+}
+
+// AbortProblem is Abort writing err with errific.WriteProblem.
+func AbortProblem(c *gingonic.Context, err error) {
+	c.Error(err)
+	errific.WriteProblem(c.Writer, err)
+	c.Abort()
+}
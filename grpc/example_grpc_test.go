@@ -0,0 +1,64 @@
+package grpc_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/leefernandes/errific"
+	errificgrpc "github.com/leefernandes/errific/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ExampleToStatus() {
+	errific.Configure() // default configuration
+	var ErrNotFound errific.Err = "thing not found"
+
+	st := errificgrpc.ToStatus(ErrNotFound.New().WithCategory(errific.CategoryNotFound))
+	fmt.Println(st.Code(), st.Message())
+
+	// Output:
+	// NotFound thing not found
+}
+
+func ExampleFromStatus() {
+	errific.Configure() // default configuration
+
+	err := errificgrpc.FromStatus(status.New(codes.Unavailable, "things unavailable"))
+	fmt.Println(err)
+	fmt.Println(errific.GetCategory(err), errific.GetHTTPStatus(err), errific.IsRetryable(err))
+
+	// Output:
+	// things unavailable
+	// network 503 true
+}
+
+func ExampleDetails() {
+	errific.Configure() // default configuration
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryNetwork).
+		WithLabel("region", "us-east-1").
+		WithRequestID("req-123").
+		WithCorrelationID("abc-123").
+		WithRetryAfter(2 * time.Second).
+		WithDoc(errific.Doc{URL: "https://docs.example.com/errors/QUERY_001", Title: "Query errors"})
+
+	// the server returns the status, the client recovers the metadata.
+	st := errificgrpc.ToStatus(err)
+	info := errific.ResolveChain(errificgrpc.FromStatus(status.Convert(st.Err())))
+	fmt.Println(st.Code(), len(st.Details()))
+	fmt.Println(info.Code, info.Category, info.Labels["region"])
+	fmt.Println(info.RequestID, info.CorrelationID)
+	fmt.Println(info.Retryable, info.RetryAfter)
+	fmt.Println(info.Docs[0].Title, info.Docs[0].URL)
+
+	// Output:
+	// Unavailable 4
+	// QUERY_001 network us-east-1
+	// req-123 abc-123
+	// true 2s
+	// Query errors https://docs.example.com/errors/QUERY_001
+}
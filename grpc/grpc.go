@@ -0,0 +1,222 @@
+// Package grpc converts errific errors to and from gRPC statuses.
+//
+// It is a separate module so errific does not depend on gRPC.
+// Import it with a name not clashing with the gRPC package.
+//
+//	import errificgrpc "github.com/leefernandes/errific/grpc"
+//
+//	func (s *server) GetThing(ctx context.Context, req *pb.GetThingRequest) (*pb.Thing, error) {
+//		thing, err := s.things.Get(ctx, req.Id)
+//		if err != nil {
+//			return nil, errificgrpc.ToStatus(err).Err()
+//		}
+//		return thing, nil
+//	}
+package grpc
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/leefernandes/errific"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain is the ErrorInfo domain of errors without a service identity.
+var Domain = "errific"
+
+// MetadataCategory is the ErrorInfo metadata key of the category.
+// The other metadata are the labels of the error.
+const MetadataCategory = "errific.category"
+
+// ToStatus returns the gRPC status of err with the code of
+// errific.MapGRPCCode and the message of its outermost errific error.
+// Errors with a gRPC status and no errific error in their chain have
+// their status. Nil errors have an OK status.
+//
+// The status has the details set in the err chain: an ErrorInfo with
+// the code as reason, the service name or Domain as domain, and the
+// category and labels as metadata, a RetryInfo with the retry delay,
+// a Help with the links of the Docs, and a RequestInfo with the request
+// ID and the correlation ID as serving data.
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	info, ok := errific.Info(err)
+	if !ok {
+		if st, ok := status.FromError(err); ok {
+			return st
+		}
+		info.Message = err.Error()
+	}
+
+	st := status.New(Code(err), info.Message)
+	if withDetails, err := st.WithDetails(Details(info)...); err == nil {
+		st = withDetails
+	}
+	return st
+}
+
+// Details returns the gRPC error details of info, see ToStatus.
+func Details(info errific.ErrorInfo) []protoadapt.MessageV1 {
+	var details []protoadapt.MessageV1
+
+	if info.Code != "" || info.Category != "" || len(info.Labels) > 0 {
+		ei := &errdetails.ErrorInfo{Reason: info.Code, Domain: Domain}
+		if info.Service != nil && info.Service.Name != "" {
+			ei.Domain = info.Service.Name
+		}
+		if len(info.Labels) > 0 || info.Category != "" {
+			ei.Metadata = make(map[string]string, len(info.Labels)+1)
+			for k, v := range info.Labels {
+				ei.Metadata[k] = v
+			}
+			if info.Category != "" {
+				ei.Metadata[MetadataCategory] = string(info.Category)
+			}
+		}
+		details = append(details, ei)
+	}
+
+	if info.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(info.RetryAfter))})
+	}
+
+	if len(info.Docs) > 0 {
+		help := &errdetails.Help{}
+		for _, doc := range info.Docs {
+			help.Links = append(help.Links, &errdetails.Help_Link{Description: doc.Title, Url: doc.URL})
+		}
+		details = append(details, help)
+	}
+
+	if info.RequestID != "" || info.CorrelationID != "" {
+		details = append(details, &errdetails.RequestInfo{RequestId: info.RequestID, ServingData: info.CorrelationID})
+	}
+
+	return details
+}
+
+// Code returns the gRPC code of errific.MapGRPCCode for err.
+func Code(err error) codes.Code {
+	var code codes.Code
+	if err := code.UnmarshalJSON([]byte(`"` + errific.MapGRPCCode(err) + `"`)); err != nil {
+		return codes.Internal
+	}
+	return code
+}
+
+// FromStatus returns an errific error with the message of st, and the
+// category, HTTP status, and retryability of its code. Unavailable,
+// ResourceExhausted, and Aborted statuses are retryable.
+// The details of st, as attached by ToStatus, set the code, category,
+// labels, retry delay, docs, and request and correlation IDs.
+// OK statuses return nil.
+//
+//	if err := errificgrpc.FromStatus(status.Convert(err)); errific.IsRetryable(err) {
+//		return retry(err)
+//	}
+func FromStatus(st *status.Status) error {
+	if st == nil || st.Code() == codes.OK {
+		return nil
+	}
+
+	code := st.Code()
+	info := errific.ErrorInfo{
+		Category:   categories[code],
+		HTTPStatus: HTTPStatus(code),
+	}
+	switch code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		info.Retryable = true
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info.Code = d.GetReason()
+			for k, v := range d.GetMetadata() {
+				if k == MetadataCategory {
+					info.Category = errific.Category(v)
+					continue
+				}
+				if info.Labels == nil {
+					info.Labels = map[string]string{}
+				}
+				info.Labels[k] = v
+			}
+
+		case *errdetails.RetryInfo:
+			if delay := d.GetRetryDelay(); delay != nil {
+				info.RetryAfter = errific.Duration(delay.AsDuration())
+				info.Retryable = true
+			}
+
+		case *errdetails.Help:
+			for _, link := range d.GetLinks() {
+				info.Docs = append(info.Docs, errific.Doc{URL: link.GetUrl(), Title: link.GetDescription()})
+			}
+
+		case *errdetails.RequestInfo:
+			info.RequestID = d.GetRequestId()
+			info.CorrelationID = d.GetServingData()
+		}
+	}
+
+	return errific.Compose(st.Message(), errific.ComposeInfo(info))
+}
+
+// categories are the errific categories of gRPC codes.
+var categories = map[codes.Code]errific.Category{
+	codes.InvalidArgument:    errific.CategoryValidation,
+	codes.OutOfRange:         errific.CategoryValidation,
+	codes.FailedPrecondition: errific.CategoryClient,
+	codes.AlreadyExists:      errific.CategoryClient,
+	codes.Canceled:           errific.CategoryClient,
+	codes.NotFound:           errific.CategoryNotFound,
+	codes.Unauthenticated:    errific.CategoryUnauthorized,
+	codes.PermissionDenied:   errific.CategoryUnauthorized,
+	codes.ResourceExhausted:  errific.CategoryRateLimited,
+	codes.DeadlineExceeded:   errific.CategoryTimeout,
+	codes.Unavailable:        errific.CategoryNetwork,
+	codes.Aborted:            errific.CategoryServer,
+	codes.Unimplemented:      errific.CategoryServer,
+	codes.Internal:           errific.CategoryServer,
+	codes.DataLoss:           errific.CategoryServer,
+	codes.Unknown:            errific.CategoryServer,
+}
+
+// HTTPStatus returns the HTTP status of a gRPC code, as mapped by gRPC gateways.
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
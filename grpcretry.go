@@ -0,0 +1,155 @@
+package errific
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GRPCRetryPolicy is the retryPolicy of a gRPC service config method.
+// Backoffs are durations in seconds with an "s" suffix, such as "0.5s".
+type GRPCRetryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// NewGRPCRetryPolicy returns the GRPCRetryPolicy retrying the MapGRPCCode
+// of the retryable errors of errs, up to maxAttempts. The backoff ranges
+// from the least to the greatest RetryAfter of the retryable errors,
+// defaulting to 0.1s and 10 times the initial backoff.
+//
+//	policy := errific.NewGRPCRetryPolicy(4, ErrUnavailable.New(), ErrThrottled.New())
+//	config, err := policy.ServiceConfig("things.v1.ThingService")
+//	conn, err := grpc.NewClient(target, grpc.WithDefaultServiceConfig(string(config)))
+func NewGRPCRetryPolicy(maxAttempts int, errs ...error) GRPCRetryPolicy {
+	var initial, maxBackoff time.Duration
+	codes := map[string]bool{}
+	for _, err := range errs {
+		if !IsRetryable(err) {
+			continue
+		}
+		codes[MapGRPCCode(err)] = true
+
+		d := GetRetryAfter(err)
+		if d > 0 && (initial == 0 || d < initial) {
+			initial = d
+		}
+		if d > maxBackoff {
+			maxBackoff = d
+		}
+	}
+
+	if initial == 0 {
+		initial = 100 * time.Millisecond
+	}
+	if maxBackoff <= initial {
+		maxBackoff = 10 * initial
+	}
+
+	p := GRPCRetryPolicy{
+		MaxAttempts:          maxAttempts,
+		InitialBackoff:       grpcDuration(initial),
+		MaxBackoff:           grpcDuration(maxBackoff),
+		BackoffMultiplier:    2,
+		RetryableStatusCodes: make([]string, 0, len(codes)),
+	}
+	for code := range codes {
+		p.RetryableStatusCodes = append(p.RetryableStatusCodes, code)
+	}
+	sort.Strings(p.RetryableStatusCodes)
+	return p
+}
+
+// grpcServiceConfig is the subset of a gRPC service config with retry policies.
+type grpcServiceConfig struct {
+	MethodConfig []grpcMethodConfig `json:"methodConfig"`
+}
+
+type grpcMethodConfig struct {
+	Name        []grpcMethodName `json:"name"`
+	RetryPolicy *GRPCRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type grpcMethodName struct {
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+}
+
+// ServiceConfig returns the gRPC service config JSON applying p to all
+// methods of services, or to all services if none are given.
+func (p GRPCRetryPolicy) ServiceConfig(services ...string) ([]byte, error) {
+	m := grpcMethodConfig{RetryPolicy: &p}
+	if len(services) == 0 {
+		services = []string{""}
+	}
+	for _, service := range services {
+		m.Name = append(m.Name, grpcMethodName{Service: service})
+	}
+	return json.Marshal(grpcServiceConfig{MethodConfig: []grpcMethodConfig{m}})
+}
+
+// ParseGRPCRetryPolicy returns the first retry policy of a gRPC service
+// config, so servers can mark errors retryable consistently with clients.
+//
+//	policy, err := errific.ParseGRPCRetryPolicy(config)
+//	err = ErrQuery.New(err).WithRetryable(policy.Retryable(err))
+func ParseGRPCRetryPolicy(serviceConfig []byte) (GRPCRetryPolicy, error) {
+	var config grpcServiceConfig
+	if err := json.Unmarshal(serviceConfig, &config); err != nil {
+		return GRPCRetryPolicy{}, err
+	}
+	for _, m := range config.MethodConfig {
+		if m.RetryPolicy != nil {
+			return *m.RetryPolicy, nil
+		}
+	}
+	return GRPCRetryPolicy{}, errors.New("errific: no retryPolicy in gRPC service config")
+}
+
+// Retryable reports whether the MapGRPCCode of err is retried by p.
+func (p GRPCRetryPolicy) Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := MapGRPCCode(err)
+	for _, c := range p.RetryableStatusCodes {
+		if strings.EqualFold(c, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the initial and max backoff of p.
+func (p GRPCRetryPolicy) Backoff() (initial, max time.Duration, err error) {
+	if initial, err = parseGRPCDuration(p.InitialBackoff); err != nil {
+		return 0, 0, err
+	}
+	max, err = parseGRPCDuration(p.MaxBackoff)
+	return initial, max, err
+}
+
+// grpcDuration formats d as seconds with an "s" suffix.
+func grpcDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// parseGRPCDuration parses seconds with an "s" suffix.
+func parseGRPCDuration(s string) (time.Duration, error) {
+	seconds, ok := strings.CutSuffix(s, "s")
+	if !ok {
+		return 0, fmt.Errorf("errific: invalid gRPC duration %q", s)
+	}
+	f, err := strconv.ParseFloat(seconds, 64)
+	if err != nil {
+		return 0, fmt.Errorf("errific: invalid gRPC duration %q", s)
+	}
+	return time.Duration(f * float64(time.Second)), nil
+}
@@ -0,0 +1,117 @@
+package errific
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Header names used by EncodeHeader and DecodeHeader.
+const (
+	HeaderCode          = "X-Errific-Code"
+	HeaderCategory      = "X-Errific-Category"
+	HeaderCorrelationID = "X-Errific-Correlation-Id"
+	HeaderRequestID     = "X-Errific-Request-Id"
+	HeaderRetryable     = "X-Errific-Retryable"
+	HeaderRetryAfter    = "X-Errific-Retry-After"
+	HeaderReplacement   = "X-Errific-Replacement-Code"
+	HeaderDeprecation   = "Deprecation"
+	HeaderSunset        = "Sunset"
+)
+
+// EncodeHeader returns the metadata of err as X-Errific-* headers,
+// so services can propagate errors without parsing response bodies.
+// Only metadata that is set is included.
+//
+//	for k, v := range errific.EncodeHeader(err) {
+//		w.Header()[k] = v
+//	}
+func EncodeHeader(err error) http.Header {
+	h := http.Header{}
+
+	if code := GetCode(err); code != "" {
+		h.Set(HeaderCode, code)
+	}
+
+	if category := GetCategory(err); category != "" {
+		h.Set(HeaderCategory, string(category))
+	}
+
+	if id := GetCorrelationID(err); id != "" {
+		h.Set(HeaderCorrelationID, id)
+	}
+
+	if id := GetRequestID(err); id != "" {
+		h.Set(HeaderRequestID, id)
+	}
+
+	if IsRetryable(err) {
+		h.Set(HeaderRetryable, "true")
+	}
+
+	if d := GetRetryAfter(err); d > 0 {
+		h.Set(HeaderRetryAfter, strconv.FormatFloat(d.Seconds(), 'f', -1, 64))
+	}
+
+	if d := GetDeprecation(err); d != nil {
+		h.Set(HeaderDeprecation, "true")
+		if d.Replacement != "" {
+			h.Set(HeaderReplacement, d.Replacement)
+		}
+		if !d.Sunset.IsZero() {
+			h.Set(HeaderSunset, d.Sunset.UTC().Format(http.TimeFormat))
+		}
+	}
+
+	return h
+}
+
+// DecodeHeader returns the ErrorInfo carried by X-Errific-* headers.
+// Malformed retry and sunset values are ignored.
+//
+//	info := errific.DecodeHeader(resp.Header)
+func DecodeHeader(h http.Header) ErrorInfo {
+	info := ErrorInfo{
+		Code:          h.Get(HeaderCode),
+		Category:      Category(h.Get(HeaderCategory)),
+		CorrelationID: h.Get(HeaderCorrelationID),
+		RequestID:     h.Get(HeaderRequestID),
+	}
+
+	if retryable, err := strconv.ParseBool(h.Get(HeaderRetryable)); err == nil {
+		info.Retryable = retryable
+	}
+
+	if secs, err := strconv.ParseFloat(h.Get(HeaderRetryAfter), 64); err == nil && secs > 0 {
+		info.RetryAfter = Duration(secs * float64(time.Second))
+		info.Retryable = true
+	}
+
+	if h.Get(HeaderDeprecation) != "" {
+		info.Deprecation = &Deprecation{Replacement: h.Get(HeaderReplacement)}
+		if sunset, err := http.ParseTime(h.Get(HeaderSunset)); err == nil {
+			info.Deprecation.Sunset = sunset
+		}
+	}
+
+	return info
+}
+
+// ErrRemote is the Err of errors reconstructed by FromHeader.
+var ErrRemote Err = "remote error"
+
+// FromHeader returns an ErrRemote error with the metadata of the
+// X-Errific-* headers of h, as decoded by DecodeHeader, so clients can
+// handle the errors of other services without parsing response bodies.
+// It returns nil if h has no code, category, or retry headers.
+//
+//	if err := errific.FromHeader(resp.Header); err != nil && errific.IsRetryable(err) {
+//		time.Sleep(errific.GetRetryAfter(err))
+//	}
+func FromHeader(h http.Header) error {
+	info := DecodeHeader(h)
+	if info.Code == "" && info.Category == "" && !info.Retryable {
+		return nil
+	}
+	return Compose(string(ErrRemote), ComposeInfo(info))
+}
@@ -0,0 +1,112 @@
+package errific
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// HealthStatus is the health of a service derived from its recent errors.
+type HealthStatus string
+
+const (
+	Healthy   HealthStatus = "healthy"
+	Degraded  HealthStatus = "degraded"
+	Unhealthy HealthStatus = "unhealthy"
+)
+
+// Thresholds configure how Journal.Health derives a HealthStatus.
+type Thresholds struct {
+	// Window is how far back errors are counted. Default is one minute.
+	Window time.Duration
+	// Degraded is the number of errors in the window at which
+	// the status is Degraded. Zero disables Degraded.
+	Degraded int
+	// Unhealthy is the number of errors in the window at which
+	// the status is Unhealthy. Zero disables Unhealthy.
+	Unhealthy int
+	// Categories limits counted errors to these categories.
+	// Default is counting all errors.
+	Categories []Category
+}
+
+// Health is the result of Journal.Health.
+type Health struct {
+	Status HealthStatus `json:"status"`
+	// Errors is the number of errors counted in the window.
+	Errors int `json:"errors"`
+	// Summaries of the counted errors by code, highest count first.
+	Summaries []Count `json:"summaries,omitempty"`
+}
+
+// Health returns the HealthStatus of the errors recorded within
+// the thresholds window, with the contributing errors summarized by code.
+//
+//	health := journal.Health(errific.Thresholds{Degraded: 10, Unhealthy: 100})
+func (j *Journal) Health(t Thresholds) Health {
+	if t.Window <= 0 {
+		t.Window = time.Minute
+	}
+
+	categories := map[Category]bool{}
+	for _, category := range t.Categories {
+		categories[category] = true
+	}
+
+	since := time.Now().Add(-t.Window)
+	index := map[string]int{}
+	var h Health
+	for _, r := range j.Records() {
+		if r.Time.Before(since) {
+			continue
+		}
+		if len(categories) > 0 && !categories[r.Category] {
+			continue
+		}
+
+		h.Errors++
+		i, ok := index[r.Code]
+		if !ok {
+			i = len(h.Summaries)
+			index[r.Code] = i
+			h.Summaries = append(h.Summaries, Count{Code: r.Code, Category: r.Category, First: r.Time})
+		}
+		h.Summaries[i].Count++
+		h.Summaries[i].Last = r.Time
+	}
+
+	sort.SliceStable(h.Summaries, func(i, j int) bool {
+		return h.Summaries[i].Count > h.Summaries[j].Count
+	})
+
+	switch {
+	case t.Unhealthy > 0 && h.Errors >= t.Unhealthy:
+		h.Status = Unhealthy
+	case t.Degraded > 0 && h.Errors >= t.Degraded:
+		h.Status = Degraded
+	default:
+		h.Status = Healthy
+	}
+
+	return h
+}
+
+// HealthHandler returns an http.Handler writing the Health of j as JSON
+// for readiness probes. Unhealthy responds 503, otherwise 200.
+//
+//	mux.Handle("/readyz", errific.HealthHandler(journal, thresholds))
+func HealthHandler(j *Journal, t Thresholds) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := j.Health(t)
+
+		status := http.StatusOK
+		if h.Status == Unhealthy {
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(h)
+	})
+}
@@ -0,0 +1,78 @@
+package errific
+
+import (
+	"slices"
+	"sort"
+)
+
+// HeatLine is the number of errors created at a line of a file.
+type HeatLine struct {
+	Line  int    `json:"line"`
+	Count uint64 `json:"count"`
+	// Codes are the distinct codes of the errors, sorted.
+	Codes []string `json:"codes,omitempty"`
+}
+
+// Heatmap maps file paths to the lines creating errors, sorted by line,
+// so editor extensions can overlay error heat on the lines producing
+// production failures. Paths are those of callers, so configure TrimCWD
+// or TrimPrefixes for paths relative to the repository.
+//
+//	{"service/things.go": [{"line": 42, "count": 1031, "codes": ["QUERY_001"]}]}
+type Heatmap map[string][]HeatLine
+
+// NewHeatmap returns the Heatmap of the callers of records.
+// Records without a caller are skipped.
+//
+//	b, _ := json.Marshal(errific.NewHeatmap(journal.Records()))
+//	os.WriteFile(".errific/heatmap.json", b, 0o644)
+func NewHeatmap(records []Record) Heatmap {
+	h := Heatmap{}
+	for _, r := range records {
+		h.add(r.Caller, r.Code, 1)
+	}
+	h.sort()
+	return h
+}
+
+// CallSiteHeatmap returns the Heatmap of call sites counted with CountCallSites.
+//
+//	h := errific.CallSiteHeatmap(errific.TopCallSites(0))
+func CallSiteHeatmap(sites []CallSite) Heatmap {
+	h := Heatmap{}
+	for _, s := range sites {
+		h.add(s.Caller, "", s.Count)
+	}
+	h.sort()
+	return h
+}
+
+// add counts n errors with code at caller.
+func (h Heatmap) add(caller, code string, n uint64) {
+	if caller == "" {
+		return
+	}
+
+	f := ParseFrame(caller)
+	lines := h[f.File]
+	i := slices.IndexFunc(lines, func(l HeatLine) bool { return l.Line == f.Line })
+	if i < 0 {
+		i = len(lines)
+		lines = append(lines, HeatLine{Line: f.Line})
+	}
+	lines[i].Count += n
+	if code != "" && !slices.Contains(lines[i].Codes, code) {
+		lines[i].Codes = append(lines[i].Codes, code)
+	}
+	h[f.File] = lines
+}
+
+// sort orders the lines of each file, and their codes.
+func (h Heatmap) sort() {
+	for _, lines := range h {
+		sort.Slice(lines, func(i, j int) bool { return lines[i].Line < lines[j].Line })
+		for _, l := range lines {
+			sort.Strings(l.Codes)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package honeybadger_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/honeybadger"
+)
+
+var ErrQuery = honeybadger.RegisterClass("ErrQuery", "error querying thing")
+
+func ExampleNewNotice() {
+	errific.Configure(errific.ServiceIdentity("things", "v1.2.3", "prod"))
+	defer errific.Configure()
+
+	err := ErrQuery.New(io.EOF).
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithCorrelationID("c-123").
+		WithLabel("region", "us-east-1")
+
+	n := honeybadger.NewNotice(err)
+	fmt.Println(n.Error.Class, n.Error.Fingerprint, n.Error.Tags, n.Error.Backtrace[0].Method)
+	fmt.Println(n.Request.Context, n.Request.Params)
+	fmt.Println(n.Server.EnvironmentName, n.Server.Revision)
+
+	var ErrUnregistered errific.Err = "error handling request"
+	fmt.Println(honeybadger.Class(ErrUnregistered.New().WithCode("HANDLE_001")))
+
+	// Output:
+	// ErrQuery QUERY_001 [server QUERY_001] ExampleNewNotice
+	// map[correlation_id:c-123] map[region:us-east-1]
+	// prod v1.2.3
+	// HANDLE_001
+}
+
+func ExampleClient_Notify() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Println(r.Header.Get("X-API-Key"))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"7c8d4c7e"}`)
+	}))
+	defer server.Close()
+
+	client := honeybadger.NewClient("api-key")
+	client.URL = server.URL
+
+	id, err := client.Notify(context.Background(), ErrQuery.New())
+	fmt.Println(id, err)
+
+	// Output:
+	// api-key
+	// 7c8d4c7e <nil>
+}
@@ -0,0 +1,223 @@
+// Package honeybadger reports errific errors to Honeybadger as notices.
+package honeybadger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/leefernandes/errific"
+)
+
+// URL of the Honeybadger notices API.
+const URL = "https://api.honeybadger.io/v1/notices"
+
+// Notice is a Honeybadger notice of an error.
+type Notice struct {
+	Notifier Notifier `json:"notifier"`
+	Error    Error    `json:"error"`
+	Request  Request  `json:"request"`
+	Server   Server   `json:"server"`
+}
+
+// Notifier identifies the notifier of a Notice.
+type Notifier struct {
+	Name    string `json:"name"`
+	URL     string `json:"url"`
+	Version string `json:"version"`
+}
+
+// Error is the class, message, backtrace, and tags of a Notice.
+type Error struct {
+	Class       string      `json:"class"`
+	Message     string      `json:"message"`
+	Backtrace   []Backtrace `json:"backtrace"`
+	Tags        []string    `json:"tags,omitempty"`
+	Fingerprint string      `json:"fingerprint,omitempty"`
+}
+
+// Backtrace is a frame of an Error backtrace.
+type Backtrace struct {
+	Number string `json:"number"`
+	File   string `json:"file"`
+	Method string `json:"method"`
+}
+
+// Request is the context and params of a Notice.
+type Request struct {
+	Context map[string]any    `json:"context,omitempty"`
+	Params  map[string]string `json:"params,omitempty"`
+}
+
+// Server is the server of a Notice.
+type Server struct {
+	EnvironmentName string `json:"environment_name,omitempty"`
+	Hostname        string `json:"hostname,omitempty"`
+	Revision        string `json:"revision,omitempty"`
+}
+
+var classes sync.Map // errific.Err -> string
+
+// RegisterClass registers name as the error class of errors created with e,
+// typically the name of its exported variable, as Go does not expose
+// variable names at run time. It returns e for declaring errors inline.
+//
+//	var ErrQuery = honeybadger.RegisterClass("ErrQuery", "error querying thing")
+func RegisterClass(name string, e errific.Err) errific.Err {
+	classes.Store(e, name)
+	return e
+}
+
+// Class returns the error class of err: the name registered with
+// RegisterClass for the outermost Err in its chain, otherwise its code,
+// otherwise its category, otherwise "Error".
+func Class(err error) string {
+	var e errific.Err
+	if errors.As(err, &e) {
+		if name, ok := classes.Load(e); ok {
+			return name.(string)
+		}
+	}
+
+	info := errific.ResolveChain(err)
+	switch {
+	case info.Code != "":
+		return info.Code
+	case info.Category != "":
+		return string(info.Category)
+	default:
+		return "Error"
+	}
+}
+
+// NewNotice returns the Notice of err.
+//
+// The class is the Class of err and the fingerprint its code. Tags are its
+// category and code, the request context its context and correlation ID,
+// request ID, and tenant, and the request params its labels. The backtrace
+// is from errific.Frames, and the environment and revision from the
+// errific.ServiceIdentity.
+func NewNotice(err error) Notice {
+	info := errific.ResolveChain(err)
+	n := Notice{
+		Notifier: Notifier{
+			Name:    "errific",
+			URL:     "https://github.com/leefernandes/errific",
+			Version: "1",
+		},
+		Error: Error{
+			Class:       Class(err),
+			Message:     err.Error(),
+			Backtrace:   []Backtrace{},
+			Fingerprint: info.Code,
+		},
+	}
+
+	for _, frame := range errific.Frames(err) {
+		n.Error.Backtrace = append(n.Error.Backtrace, Backtrace{
+			Number: fmt.Sprint(frame.Line),
+			File:   frame.File,
+			Method: frame.Function,
+		})
+	}
+	for _, tag := range []string{string(info.Category), info.Code} {
+		if tag != "" {
+			n.Error.Tags = append(n.Error.Tags, tag)
+		}
+	}
+
+	context := map[string]any{}
+	for k, v := range info.Context {
+		context[k] = v
+	}
+	for k, v := range map[string]string{
+		"correlation_id": info.CorrelationID,
+		"request_id":     info.RequestID,
+		"tenant":         info.Tenant,
+	} {
+		if v != "" {
+			context[k] = v
+		}
+	}
+	if len(context) > 0 {
+		n.Request.Context = context
+	}
+	n.Request.Params = info.Labels
+
+	n.Server.Hostname, _ = os.Hostname()
+	if s := info.Service; s != nil {
+		n.Server.EnvironmentName = s.Env
+		n.Server.Revision = s.Version
+	}
+
+	return n
+}
+
+// Client sends notices to Honeybadger.
+//
+//	client := honeybadger.NewClient(apiKey)
+//
+//	id, err := client.Notify(ctx, err)
+type Client struct {
+	// APIKey of the Honeybadger project.
+	APIKey string
+	// URL of the notices API, URL if empty.
+	URL string
+	// HTTPClient sends requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// NewClient returns a Client reporting to the project of apiKey.
+func NewClient(apiKey string) *Client {
+	return &Client{APIKey: apiKey, URL: URL}
+}
+
+// Notify sends the Notice of err, returning the ID of the notice.
+// Nil errors are ignored.
+func (c *Client) Notify(ctx context.Context, err error) (string, error) {
+	if err == nil {
+		return "", nil
+	}
+
+	body, marshalErr := json.Marshal(NewNotice(err))
+	if marshalErr != nil {
+		return "", marshalErr
+	}
+	url := c.URL
+	if url == "" {
+		url = URL
+	}
+	req, reqErr := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if reqErr != nil {
+		return "", reqErr
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-API-Key", c.APIKey)
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, doErr := client.Do(req)
+	if doErr != nil {
+		return "", doErr
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return "", fmt.Errorf("honeybadger: notify %s: %s", url, resp.Status)
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if decodeErr := json.NewDecoder(resp.Body).Decode(&created); decodeErr != nil {
+		return "", decodeErr
+	}
+	return created.ID, nil
+}
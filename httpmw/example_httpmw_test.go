@@ -0,0 +1,81 @@
+package httpmw_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/httpmw"
+)
+
+func ExampleHandlerFunc() {
+	errific.Configure() // default configuration
+	var ErrQuery errific.Err = "error querying thing"
+
+	h := httpmw.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return ErrQuery.New().
+			WithCode("QUERY_001").
+			WithHTTPStatus(http.StatusServiceUnavailable).
+			WithRetryable(true).
+			WithRetryAfter(1500 * time.Millisecond)
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/things/1", nil))
+
+	fmt.Println(w.Code)
+	fmt.Println(w.Header().Get("Content-Type"))
+	fmt.Println(w.Header().Get("Retry-After"))
+	fmt.Println(w.Header().Get(errific.HeaderCode))
+
+	// Output:
+	// 503
+	// application/json
+	// 2
+	// QUERY_001
+}
+
+func ExampleHandlerFunc_rateLimited() {
+	errific.Configure() // default configuration
+
+	h := httpmw.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return errific.RateLimited(10, 0, time.Now().Add(time.Minute))
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/things", nil))
+	fmt.Println(w.Code, w.Header().Get("RateLimit-Limit"), w.Header().Get("RateLimit-Remaining"), w.Header().Get("Retry-After"))
+
+	// Output:
+	// 429 10 0 60
+}
+
+func ExampleMiddleware() {
+	errific.Configure() // default configuration
+	var ErrNotFound errific.Err = "thing not found"
+
+	mw := httpmw.Middleware{
+		ProblemDetails: true,
+		Report: func(r *http.Request, err error) {
+			fmt.Println("reported:", errific.GetCode(err))
+		},
+	}
+	h := mw.Handle(func(w http.ResponseWriter, r *http.Request) error {
+		return ErrNotFound.New().WithCode("THING_404").WithCategory(errific.CategoryNotFound).WithRequestID("req-123")
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/things/1", nil))
+
+	fmt.Println(w.Code)
+	fmt.Println(w.Header().Get("Content-Type"))
+	fmt.Print(w.Body.String())
+
+	// Output:
+	// reported: THING_404
+	// 404
+	// application/problem+json
+	// {"type":"about:blank","title":"Not Found","status":404,"detail":"thing not found","instance":"req-123","code":"THING_404"}
+}
@@ -0,0 +1,95 @@
+// Package httpmw writes errors returned by net/http handlers as responses.
+//
+// Handlers return errors instead of writing them, and the middleware
+// writes them with errific.WriteHTTP, or errific.WriteProblem.
+//
+//	mux.Handle("/things/{id}", httpmw.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+//		thing, err := getThing(r.Context(), r.PathValue("id"))
+//		if err != nil {
+//			return err
+//		}
+//		return json.NewEncoder(w).Encode(thing)
+//	}))
+package httpmw
+
+import (
+	"net/http"
+
+	"github.com/leefernandes/errific"
+)
+
+// HandlerFunc is an http.Handler returning errors to write as responses.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTP calls h, writing its error with errific.WriteHTTP.
+func (h HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	Middleware{}.serve(h, w, r)
+}
+
+// Middleware writes the errors returned by HandlerFuncs as responses.
+//
+//	mw := httpmw.Middleware{ProblemDetails: true, Report: func(r *http.Request, err error) {
+//		slog.Error("error handling request", "err", err)
+//	}}
+//	mux.Handle("/things/{id}", mw.Handle(getThing))
+type Middleware struct {
+	// ProblemDetails writes errors with errific.WriteProblem instead of errific.WriteHTTP.
+	ProblemDetails bool
+	// Report, if not nil, is called with errors before they are written.
+	Report func(r *http.Request, err error)
+}
+
+// Handle returns an http.Handler calling h, writing its error.
+func (m Middleware) Handle(h HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.serve(h, w, r)
+	})
+}
+
+// serve calls h, writing its error unless h had written a response.
+// Errors are reported with errific.AddError and to m.Report.
+func (m Middleware) serve(h HandlerFunc, w http.ResponseWriter, r *http.Request) {
+	sw := &statusWriter{ResponseWriter: w}
+	err := h(sw, r)
+	if err == nil {
+		return
+	}
+
+	errific.AddError(r.Context(), err)
+	if m.Report != nil {
+		m.Report(r, err)
+	}
+	if sw.wroteHeader {
+		return
+	}
+	m.Write(w, err)
+}
+
+// Write writes err with errific.WriteHTTP, or errific.WriteProblem.
+func (m Middleware) Write(w http.ResponseWriter, err error) {
+	if m.ProblemDetails {
+		errific.WriteProblem(w, err)
+		return
+	}
+	errific.WriteHTTP(w, err)
+}
+
+// statusWriter records whether a response header was written.
+type statusWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
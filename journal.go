@@ -0,0 +1,110 @@
+package errific
+
+import (
+	"sync"
+	"time"
+)
+
+// Record is a fixed-size summary of an error kept by a Journal.
+type Record struct {
+	Time       time.Time
+	Message    string
+	Code       string
+	Category   Category
+	Caller     string
+	HTTPStatus int
+	Retryable  bool
+}
+
+// NewRecord summarizes err as a Record.
+// Message is the message of the outermost errific error, or err.Error()
+// for other errors, so recording errific errors does not format wrapped messages.
+func NewRecord(err error) Record {
+	r := Record{
+		Time:       time.Now(),
+		Code:       GetCode(err),
+		Category:   GetCategory(err),
+		HTTPStatus: GetHTTPStatus(err),
+		Retryable:  IsRetryable(err),
+	}
+
+	r.Message, r.Caller = outermost(err)
+	return r
+}
+
+// Journal keeps the most recent errors in a bounded ring of Records.
+// Storage is allocated once by NewJournal, so long-running collectors
+// do not allocate per recorded error.
+// A Journal is safe for concurrent use.
+//
+//	var journal = errific.NewJournal(1024)
+//
+//	journal.Record(err)
+type Journal struct {
+	mu      sync.Mutex
+	records []Record
+	next    int
+	full    bool
+	total   uint64
+}
+
+// NewJournal returns a Journal that keeps the last size Records.
+func NewJournal(size int) *Journal {
+	if size < 1 {
+		size = 1
+	}
+	return &Journal{records: make([]Record, size)}
+}
+
+// Record adds err to the journal, overwriting the oldest Record when full.
+// Nil errors are ignored.
+func (j *Journal) Record(err error) {
+	if err == nil {
+		return
+	}
+	j.Add(NewRecord(err))
+}
+
+// Add adds r to the journal, overwriting the oldest Record when full.
+func (j *Journal) Add(r Record) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.records[j.next] = r
+	j.next++
+	j.total++
+	if j.next == len(j.records) {
+		j.next = 0
+		j.full = true
+	}
+}
+
+// Records returns a copy of the kept Records, oldest first.
+func (j *Journal) Records() []Record {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.full {
+		return append([]Record(nil), j.records[:j.next]...)
+	}
+
+	records := make([]Record, 0, len(j.records))
+	records = append(records, j.records[j.next:]...)
+	return append(records, j.records[:j.next]...)
+}
+
+// Len returns the number of kept Records.
+func (j *Journal) Len() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.full {
+		return len(j.records)
+	}
+	return j.next
+}
+
+// Total returns the number of Records ever added, including overwritten ones.
+func (j *Journal) Total() uint64 {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.total
+}
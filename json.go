@@ -0,0 +1,237 @@
+package errific
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Context keys of compressed context in JSON, see CompressContext.
+const (
+	ContextEncoding = "_encoding"
+	ContextData     = "_data"
+)
+
+// EncodingGzip marks context compressed with gzip and encoded as base64.
+const EncodingGzip = "gzip+base64"
+
+// errorJSON is the JSON representation of an error.
+type errorJSON struct {
+	ErrorInfo
+	Wrapped []string `json:"wrapped,omitempty"`
+	Stack   string   `json:"stack,omitempty"`
+	// Depth and WrapCount of the chain with the ChainStats option.
+	Depth     int `json:"depth,omitempty"`
+	WrapCount int `json:"wrap_count,omitempty"`
+	// Dropped names the fields dropped to fit MaxJSONSize.
+	Dropped []string `json:"dropped,omitempty"`
+}
+
+// MarshalJSON encodes the ErrorInfo of the error chain as resolved by
+// ResolveChain, with the wrapped error messages and stack.
+// Context and expectation values failing to encode are replaced with
+// placeholders naming their type, such as "<unserializable chan int>".
+//
+// With ChainStats, the Depth and WrapCount of the chain are included.
+// With CompressContext, context larger than the threshold is encoded as
+// gzip compressed base64 JSON under the _data key, marked by _encoding.
+// With MaxJSONSize, fields are dropped in order stack, context, labels,
+// and wrapped until the output fits, and named in "dropped", then the
+// message is truncated with Truncate.
+func (e errific) MarshalJSON() ([]byte, error) {
+	v := errorJSON{
+		Stack:     string(e.stack),
+		ErrorInfo: ResolveChain(e),
+	}
+	for _, err := range e.errs {
+		v.Wrapped = append(v.Wrapped, mask(err.Error()))
+	}
+	if c.chainStats {
+		v.Depth, v.WrapCount = Depth(e), WrapCount(e)
+	}
+
+	b, err := marshalErrorJSON(&v)
+	if err != nil || c.maxJSONSize <= 0 {
+		return b, err
+	}
+
+	drops := []struct {
+		field string
+		set   bool
+		drop  func()
+	}{
+		{"stack", v.Stack != "", func() { v.Stack = "" }},
+		{"context", v.Context != nil, func() { v.Context = nil }},
+		{"labels", v.Labels != nil, func() { v.Labels = nil }},
+		{"wrapped", v.Wrapped != nil, func() { v.Wrapped = nil }},
+	}
+	for _, d := range drops {
+		if len(b) <= c.maxJSONSize {
+			break
+		}
+		if !d.set {
+			continue
+		}
+		d.drop()
+		v.Dropped = append(v.Dropped, d.field)
+		if b, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	// truncate the message as a last resort, until it fits or is empty.
+	for len(b) > c.maxJSONSize && v.Message != "" {
+		n := len(v.Message) - (len(b) - c.maxJSONSize)
+		if n >= len(v.Message) {
+			n = len(v.Message) - 1
+		}
+		v.Message = Truncate(v.Message, max(n, 0))
+		if b, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// SerializedSize returns the size in bytes of err encoded as JSON,
+// after dropping fields to fit MaxJSONSize.
+// Errors other than errific are encoded as the ErrorInfo of their chain.
+func SerializedSize(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var b []byte
+	if e, ok := err.(errific); ok {
+		b, _ = e.MarshalJSON()
+	} else {
+		b, _ = marshalErrorJSON(&errorJSON{ErrorInfo: ResolveChain(err)})
+	}
+	return len(b)
+}
+
+// marshalErrorJSON encodes v, compressing its context with CompressContext.
+// If v fails to encode, it is encoded again with serializable values.
+func marshalErrorJSON(v *errorJSON) ([]byte, error) {
+	marshal := func() ([]byte, error) {
+		if c.compressContext > 0 && v.Context != nil {
+			context, err := compressContext(v.Context, c.compressContext)
+			if err != nil {
+				return nil, err
+			}
+			v.Context = context
+		}
+		return json.Marshal(v)
+	}
+
+	b, err := marshal()
+	if err == nil {
+		return b, nil
+	}
+	v.ErrorInfo = serializable(v.ErrorInfo)
+	return marshal()
+}
+
+// serializable returns info with context and expectation values failing
+// to encode as JSON replaced with placeholders naming their type.
+func serializable(info ErrorInfo) ErrorInfo {
+	if len(info.Context) > 0 {
+		context := make(map[string]any, len(info.Context))
+		for k, v := range info.Context {
+			context[k] = serializableValue(v)
+		}
+		info.Context = context
+	}
+	if len(info.Expectations) > 0 {
+		expectations := make([]Mismatch, len(info.Expectations))
+		for i, m := range info.Expectations {
+			m.Expected, m.Actual = serializableValue(m.Expected), serializableValue(m.Actual)
+			expectations[i] = m
+		}
+		info.Expectations = expectations
+	}
+	return info
+}
+
+// serializableValue returns v, or a placeholder naming its type
+// if it fails to encode as JSON.
+func serializableValue(v any) any {
+	if _, err := json.Marshal(v); err != nil {
+		return unserializable(v)
+	}
+	return v
+}
+
+// unserializable returns the placeholder of v failing to encode as JSON.
+func unserializable(v any) string {
+	return fmt.Sprintf("<unserializable %T>", v)
+}
+
+// compressContext returns context compressed when its JSON exceeds threshold bytes.
+func compressContext(context map[string]any, threshold int) (map[string]any, error) {
+	b, err := json.Marshal(context)
+	if err != nil || len(b) <= threshold {
+		return context, err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		ContextEncoding: EncodingGzip,
+		ContextData:     base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+// decompressContext returns context decompressed when marked by _encoding.
+func decompressContext(context map[string]any) (map[string]any, error) {
+	if context[ContextEncoding] != EncodingGzip {
+		return context, nil
+	}
+
+	data, _ := context[ContextData].(string)
+	b, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if b, err = io.ReadAll(zr); err != nil {
+		return nil, err
+	}
+
+	var decompressed map[string]any
+	err = json.Unmarshal(b, &decompressed)
+	return decompressed, err
+}
+
+// FromJSON decodes the ErrorInfo of an error encoded as JSON,
+// decompressing context compressed with CompressContext.
+//
+//	info, err := errific.FromJSON(body)
+func FromJSON(b []byte) (ErrorInfo, error) {
+	var info ErrorInfo
+	if err := json.Unmarshal(b, &info); err != nil {
+		return info, err
+	}
+
+	context, err := decompressContext(info.Context)
+	if err != nil {
+		return info, err
+	}
+	info.Context = context
+	return info, nil
+}
@@ -0,0 +1,95 @@
+package errific
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Context keys of the JSON:API error source.
+const (
+	// ContextSourcePointer is a JSON Pointer to the request document value causing the error.
+	ContextSourcePointer = "source_pointer"
+	// ContextSourceParameter is the query parameter causing the error.
+	ContextSourceParameter = "source_parameter"
+	// ContextSourceHeader is the request header causing the error.
+	ContextSourceHeader = "source_header"
+)
+
+// JSONAPIError is a JSON:API error object.
+type JSONAPIError struct {
+	ID     string         `json:"id,omitempty"`
+	Status string         `json:"status,omitempty"`
+	Code   string         `json:"code,omitempty"`
+	Title  string         `json:"title,omitempty"`
+	Detail string         `json:"detail,omitempty"`
+	Source *JSONAPISource `json:"source,omitempty"`
+	Meta   map[string]any `json:"meta,omitempty"`
+}
+
+// JSONAPISource is the source of a JSONAPIError.
+type JSONAPISource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+	Header    string `json:"header,omitempty"`
+}
+
+// ToJSONAPIErrors returns the JSON:API error objects of err, one for each
+// errific error in its chain, outermost first. The ID is the request ID,
+// otherwise the correlation ID, the status is the HTTP status mapped like
+// MapHTTPStatus with its text as title, the detail is the message, the
+// source is from the Context*Source keys, and the meta is the rest of the
+// context. Errors without an errific error have one object with the message.
+//
+//	json.NewEncoder(w).Encode(map[string]any{"errors": errific.ToJSONAPIErrors(err)})
+func ToJSONAPIErrors(err error) []JSONAPIError {
+	if err == nil {
+		return nil
+	}
+
+	chain := Chain(err)
+	if len(chain) == 0 {
+		return []JSONAPIError{{
+			Status: strconv.Itoa(http.StatusInternalServerError),
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Detail: mask(err.Error()),
+		}}
+	}
+
+	errs := make([]JSONAPIError, 0, len(chain))
+	for _, info := range chain {
+		status := mapHTTPStatus(info.HTTPStatus, info.Category)
+		e := JSONAPIError{
+			ID:     info.RequestID,
+			Status: strconv.Itoa(status),
+			Code:   info.Code,
+			Title:  http.StatusText(status),
+			Detail: info.Message,
+		}
+		if e.ID == "" {
+			e.ID = info.CorrelationID
+		}
+
+		var source JSONAPISource
+		for k, v := range info.Context {
+			s, _ := v.(string)
+			switch k {
+			case ContextSourcePointer:
+				source.Pointer = s
+			case ContextSourceParameter:
+				source.Parameter = s
+			case ContextSourceHeader:
+				source.Header = s
+			default:
+				if e.Meta == nil {
+					e.Meta = map[string]any{}
+				}
+				e.Meta[k] = v
+			}
+		}
+		if source != (JSONAPISource{}) {
+			e.Source = &source
+		}
+		errs = append(errs, e)
+	}
+	return errs
+}
@@ -0,0 +1,65 @@
+package errific
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+// LevelCritical is a slog.Level above slog.LevelError for faults
+// that need immediate attention.
+const LevelCritical = slog.LevelError + 4
+
+var defaultLevels = map[Category]slog.Level{
+	CategoryClient:       slog.LevelWarn,
+	CategoryValidation:   slog.LevelWarn,
+	CategoryNotFound:     slog.LevelWarn,
+	CategoryUnauthorized: slog.LevelWarn,
+	CategoryRateLimited:  slog.LevelWarn,
+	CategoryServer:       slog.LevelError,
+	CategoryNetwork:      slog.LevelError,
+	CategoryTimeout:      slog.LevelError,
+}
+
+type logLevelOption struct {
+	level      slog.Level
+	categories []Category
+}
+
+func (logLevelOption) ErrificOption() {}
+
+var (
+	// LogLevelFor errors with categories, overriding the default mapping of LogLevel.
+	//
+	//	errific.Configure(errific.LogLevelFor(errific.LevelCritical, errific.CategoryServer))
+	LogLevelFor = func(level slog.Level, categories ...Category) logLevelOption {
+		return logLevelOption{level: level, categories: categories}
+	}
+)
+
+// LogLevel returns the slog.Level to log err at, so integrations emit WARN
+// for expected client errors and ERROR only for server faults.
+//
+// The level is the configured LogLevelFor the Category of err, otherwise
+// WARN for client, validation, not found, unauthorized, and rate limited
+// errors, and ERROR for server, network, and timeout errors.
+// Errors without a Category are WARN for a 4xx HTTP status and ERROR otherwise.
+//
+//	logger.Log(ctx, errific.LogLevel(err), "error handling request", "err", err)
+func LogLevel(err error) slog.Level {
+	return levelOf(GetCategory(err), GetHTTPStatus(err))
+}
+
+// levelOf returns the slog.Level of errors with category and HTTP status.
+func levelOf(category Category, status int) slog.Level {
+	if level, ok := c.levels[category]; ok {
+		return level
+	}
+	if level, ok := defaultLevels[category]; ok {
+		return level
+	}
+
+	if status >= http.StatusBadRequest && status < http.StatusInternalServerError {
+		return slog.LevelWarn
+	}
+	return slog.LevelError
+}
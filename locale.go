@@ -0,0 +1,54 @@
+package errific
+
+import (
+	"fmt"
+	"time"
+)
+
+// Locale formats durations, numbers, and timestamps of human readable
+// output such as OutputCI, for operator facing tools presenting errors in
+// other languages. Nil functions use the default formatting.
+// JSON and logfmt output stay canonical.
+type Locale struct {
+	// Duration formats durations, such as retry delays.
+	Duration func(time.Duration) string
+	// Number formats integer and floating point context values.
+	Number func(any) string
+	// Time formats timestamps.
+	Time func(time.Time) string
+}
+
+type localizeOption Locale
+
+func (localizeOption) ErrificOption() {}
+
+var (
+	// Localize human readable output with l.
+	//
+	//	errific.Configure(errific.OutputCI, errific.Localize(errific.Locale{
+	//		Duration: func(d time.Duration) string { return fmt.Sprintf("%.0f Sekunden", d.Seconds()) },
+	//	}))
+	Localize = func(l Locale) localizeOption {
+		return localizeOption(l)
+	}
+)
+
+// localize formats v for human readable output with the configured Locale.
+func localize(v any) string {
+	l := c.locale
+	switch v := v.(type) {
+	case time.Duration:
+		if l.Duration != nil {
+			return l.Duration(v)
+		}
+	case time.Time:
+		if l.Time != nil {
+			return l.Time(v)
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		if l.Number != nil {
+			return l.Number(v)
+		}
+	}
+	return fmt.Sprint(v)
+}
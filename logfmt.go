@@ -0,0 +1,128 @@
+package errific
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// OutputLogfmt formats Error() output as a logfmt line,
+	// for pipelines that parse logfmt natively such as Grafana Loki.
+	//
+	//	errific.Configure(errific.OutputLogfmt)
+	OutputLogfmt outputOption = "logfmt"
+)
+
+func init() {
+	RegisterFormat(string(OutputLogfmt), marshalLogfmt)
+}
+
+// marshalLogfmt encodes info as key=value pairs, quoting values with
+// spaces, equals signs, quotes, or control characters.
+// Labels, context, and custom fields are flattened as label.<key>,
+// context.<key>, and field.<name>, expectations as
+// expectation.<field>.expected and .actual, and docs as doc.<index>.url,
+// .title, .snippet, and .lang.
+func marshalLogfmt(info ErrorInfo) ([]byte, error) {
+	var b strings.Builder
+	pair := func(key, value string) {
+		if value == "" {
+			return
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(key)
+		b.WriteByte('=')
+		b.WriteString(logfmtValue(value))
+	}
+
+	pair("message", info.Message)
+	pair("caller", info.Caller)
+	pair("code", info.Code)
+	pair("category", string(info.Category))
+	pair("correlation_id", info.CorrelationID)
+	pair("request_id", info.RequestID)
+	pair("tenant", info.Tenant)
+	if info.HTTPStatus != 0 {
+		pair("http_status", strconv.Itoa(info.HTTPStatus))
+	}
+	if info.Retryable {
+		pair("retryable", "true")
+	}
+	if info.RetryAfter > 0 {
+		pair("retry_after", info.RetryAfter.String())
+	}
+	if u := info.Upstream; u != nil {
+		pair("upstream.service", u.Service)
+		pair("upstream.code", u.Code)
+		if u.Status != 0 {
+			pair("upstream.status", strconv.Itoa(u.Status))
+		}
+	}
+	if svc := info.Service; svc != nil {
+		pair("service.name", svc.Name)
+		pair("service.version", svc.Version)
+		pair("service.env", svc.Env)
+	}
+	if cl := info.Classification; cl != nil {
+		pair("classification.source", cl.Source)
+		pair("classification.confidence", strconv.FormatFloat(cl.Confidence, 'g', -1, 64))
+	}
+	if d := info.Deprecation; d != nil {
+		pair("deprecation.replacement", d.Replacement)
+		if !d.Sunset.IsZero() {
+			pair("deprecation.sunset", d.Sunset.Format(time.RFC3339))
+		}
+	}
+	if ref := info.LogRef; ref != nil {
+		pair("log_ref.stream", ref.Stream)
+		pair("log_ref.offset", ref.Offset)
+	}
+	pair("cancel_cause", info.CancelCause)
+	for _, m := range info.Expectations {
+		pair("expectation."+m.Field+".expected", fmt.Sprint(m.Expected))
+		pair("expectation."+m.Field+".actual", fmt.Sprint(m.Actual))
+	}
+	for _, k := range sortedKeys(info.Labels) {
+		pair("label."+k, info.Labels[k])
+	}
+	for _, k := range sortedKeys(info.Context) {
+		pair("context."+k, fmt.Sprint(info.Context[k]))
+	}
+	for _, k := range sortedKeys(info.Fields) {
+		pair("field."+k, fmt.Sprint(info.Fields[k]))
+	}
+	for i, doc := range info.Docs {
+		prefix := "doc." + strconv.Itoa(i) + "."
+		pair(prefix+"url", doc.URL)
+		pair(prefix+"title", doc.Title)
+		pair(prefix+"snippet", doc.Snippet)
+		pair(prefix+"lang", doc.Lang)
+	}
+
+	return []byte(b.String()), nil
+}
+
+// logfmtValue returns v quoted when it is not a bare logfmt value.
+func logfmtValue(v string) string {
+	if strings.IndexFunc(v, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == '\\' || unicode.IsSpace(r) || !unicode.IsPrint(r)
+	}) == -1 {
+		return v
+	}
+	return strconv.Quote(v)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,122 @@
+package errific
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// LogValue returns the message, caller, metadata, and stack of the error
+// chain as a slog group, so errors logged with slog keep their structure.
+// Labels, context, custom fields, upstream, service, classification,
+// deprecation, log ref, expectations by field, and docs by index
+// are nested groups.
+// Unset fields are omitted.
+//
+//	slog.Error("error handling request", "err", err)
+func (e errific) LogValue() slog.Value {
+	info := ResolveChain(e)
+	attrs := []slog.Attr{slog.String("message", info.Message)}
+	str := func(key, value string) {
+		if value != "" {
+			attrs = append(attrs, slog.String(key, value))
+		}
+	}
+
+	str("caller", info.Caller)
+	str("code", info.Code)
+	str("category", string(info.Category))
+	str("correlation_id", info.CorrelationID)
+	str("request_id", info.RequestID)
+	str("tenant", info.Tenant)
+	if info.HTTPStatus != 0 {
+		attrs = append(attrs, slog.Int("http_status", info.HTTPStatus))
+	}
+	if info.Retryable {
+		attrs = append(attrs, slog.Bool("retryable", true))
+	}
+	if info.RetryAfter != 0 {
+		attrs = append(attrs, slog.Duration("retry_after", time.Duration(info.RetryAfter)))
+	}
+
+	if len(info.Labels) > 0 {
+		var labels []any
+		for _, k := range sortedKeys(info.Labels) {
+			labels = append(labels, slog.String(k, info.Labels[k]))
+		}
+		attrs = append(attrs, slog.Group("labels", labels...))
+	}
+	if len(info.Context) > 0 {
+		var context []any
+		for _, k := range sortedKeys(info.Context) {
+			context = append(context, slog.Any(k, info.Context[k]))
+		}
+		attrs = append(attrs, slog.Group("context", context...))
+	}
+	if len(info.Fields) > 0 {
+		var fields []any
+		for _, k := range sortedKeys(info.Fields) {
+			fields = append(fields, slog.Any(k, info.Fields[k]))
+		}
+		attrs = append(attrs, slog.Group("fields", fields...))
+	}
+	if u := info.Upstream; u != nil {
+		attrs = append(attrs, slog.Group("upstream",
+			slog.String("service", u.Service),
+			slog.String("code", u.Code),
+			slog.Int("status", u.Status),
+		))
+	}
+	if s := info.Service; s != nil {
+		attrs = append(attrs, slog.Group("service",
+			slog.String("name", s.Name),
+			slog.String("version", s.Version),
+			slog.String("env", s.Env),
+		))
+	}
+	if cl := info.Classification; cl != nil {
+		attrs = append(attrs, slog.Group("classification",
+			slog.String("source", cl.Source),
+			slog.Float64("confidence", cl.Confidence),
+		))
+	}
+	if d := info.Deprecation; d != nil {
+		attrs = append(attrs, slog.Group("deprecation",
+			slog.String("replacement", d.Replacement),
+			slog.Time("sunset", d.Sunset),
+		))
+	}
+	if r := info.LogRef; r != nil {
+		attrs = append(attrs, slog.Group("log_ref",
+			slog.String("stream", r.Stream),
+			slog.String("offset", r.Offset),
+		))
+	}
+	str("cancel_cause", info.CancelCause)
+	if len(info.Expectations) > 0 {
+		var expectations []any
+		for _, m := range info.Expectations {
+			expectations = append(expectations, slog.Group(m.Field,
+				slog.Any("expected", m.Expected),
+				slog.Any("actual", m.Actual),
+			))
+		}
+		attrs = append(attrs, slog.Group("expectations", expectations...))
+	}
+	if len(info.Docs) > 0 {
+		var docs []any
+		for i, doc := range info.Docs {
+			var fields []any
+			for _, f := range [][2]string{{"url", doc.URL}, {"title", doc.Title}, {"snippet", doc.Snippet}, {"lang", doc.Lang}} {
+				if f[1] != "" {
+					fields = append(fields, slog.String(f[0], f[1]))
+				}
+			}
+			docs = append(docs, slog.Group(strconv.Itoa(i), fields...))
+		}
+		attrs = append(attrs, slog.Group("docs", docs...))
+	}
+	str("stack", string(e.stack))
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,32 @@
+package loki_test
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/loki"
+)
+
+func ExampleClient() {
+	errific.Configure(errific.NoCapture)
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	client := loki.NewClient("http://loki:3100/loki/api/v1/push", map[string]string{"app": "things"}, "region")
+	client.MaxLabelValues = 1
+
+	for _, region := range []string{"us-east-1", "us-east-1", "eu-west-1"} {
+		_ = client.Record(ErrQuery.New().
+			WithCode("QUERY_001").
+			WithCategory(errific.CategoryServer).
+			WithLabels(map[string]string{"region": region, "host": "a1"}))
+	}
+
+	for _, s := range client.Batch().Streams {
+		fmt.Println(s.Stream, len(s.Values), s.Values[0][1])
+	}
+
+	// Output:
+	// map[app:things category:server region:other] 1 {"message":"error querying thing","code":"QUERY_001","category":"server","labels":{"host":"a1","region":"eu-west-1"}}
+	// map[app:things category:server region:us-east-1] 2 {"message":"error querying thing","code":"QUERY_001","category":"server","labels":{"host":"a1","region":"us-east-1"}}
+}
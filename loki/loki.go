@@ -0,0 +1,238 @@
+// Package loki pushes errific errors to Grafana Loki.
+//
+// Errors are grouped into streams by a bounded set of Loki labels, and
+// the rest of their metadata is the log line, encoded as JSON.
+package loki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// Other replaces label values beyond MaxLabelValues.
+const Other = "other"
+
+// Stream is a stream of the Loki push API.
+type Stream struct {
+	Stream map[string]string `json:"stream"`
+	// Values are pairs of unix nanosecond timestamp and log line.
+	Values [][2]string `json:"values"`
+}
+
+// Push is a Loki push API request.
+type Push struct {
+	Streams []Stream `json:"streams"`
+}
+
+// Client batches errors into streams and pushes them to Loki.
+// A Client is safe for concurrent use.
+//
+//	client := loki.NewClient("http://loki:3100/loki/api/v1/push", map[string]string{"app": "things"}, "region")
+//	go client.Run(ctx, 5*time.Second, nil)
+//
+//	client.Record(err)
+type Client struct {
+	// URL of the Loki push API.
+	URL string
+	// HTTPClient sends requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+	// Labels are static Loki labels of all streams.
+	Labels map[string]string
+	// LabelKeys are the errific label keys promoted to Loki labels,
+	// in addition to the category. Other labels stay in the log line.
+	LabelKeys []string
+	// MaxLabelValues bounds the distinct values of each promoted label.
+	// Further values are replaced with Other. Default is 100.
+	MaxLabelValues int
+
+	mu      sync.Mutex
+	streams map[string]*Stream
+	values  map[string]map[string]bool
+}
+
+// NewClient returns a Client pushing to url with static labels,
+// promoting the errific labels of labelKeys to Loki labels.
+func NewClient(url string, labels map[string]string, labelKeys ...string) *Client {
+	return &Client{URL: url, Labels: labels, LabelKeys: labelKeys}
+}
+
+// Record adds err to the stream of its labels. Nil errors are ignored.
+func (c *Client) Record(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	line, marshalErr := Line(err)
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	labels := c.streamLabels(err)
+	key := labelsKey(labels)
+	if c.streams == nil {
+		c.streams = map[string]*Stream{}
+	}
+	s, ok := c.streams[key]
+	if !ok {
+		s = &Stream{Stream: labels}
+		c.streams[key] = s
+	}
+	s.Values = append(s.Values, [2]string{strconv.FormatInt(time.Now().UnixNano(), 10), string(line)})
+	return nil
+}
+
+// streamLabels returns the Loki labels of err, bounding the values of
+// promoted labels. c.mu must be held.
+func (c *Client) streamLabels(err error) map[string]string {
+	labels := make(map[string]string, len(c.Labels)+len(c.LabelKeys)+1)
+	for k, v := range c.Labels {
+		labels[k] = v
+	}
+
+	info := errific.ResolveChain(err)
+	if info.Category != "" {
+		labels["category"] = c.bound("category", string(info.Category))
+	}
+	for _, k := range c.LabelKeys {
+		if v, ok := info.Labels[k]; ok {
+			labels[k] = c.bound(k, v)
+		}
+	}
+	return labels
+}
+
+// bound returns value, or Other once key has MaxLabelValues distinct values.
+func (c *Client) bound(key, value string) string {
+	max := c.MaxLabelValues
+	if max <= 0 {
+		max = 100
+	}
+	if c.values == nil {
+		c.values = map[string]map[string]bool{}
+	}
+	seen := c.values[key]
+	if seen == nil {
+		seen = map[string]bool{}
+		c.values[key] = seen
+	}
+	if !seen[value] && len(seen) >= max {
+		return Other
+	}
+	seen[value] = true
+	return value
+}
+
+// Batch returns and resets the recorded streams.
+func (c *Client) Batch() Push {
+	c.mu.Lock()
+	streams := c.streams
+	c.streams = nil
+	c.mu.Unlock()
+
+	keys := make([]string, 0, len(streams))
+	for k := range streams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	push := Push{Streams: make([]Stream, 0, len(keys))}
+	for _, k := range keys {
+		push.Streams = append(push.Streams, *streams[k])
+	}
+	return push
+}
+
+// Flush pushes the recorded streams to Loki.
+func (c *Client) Flush(ctx context.Context) error {
+	push := c.Batch()
+	if len(push.Streams) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(push)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("loki: push %s: %s", c.URL, resp.Status)
+	}
+	return nil
+}
+
+// Run calls Flush every interval until ctx is done, then flushes
+// once more with a background context. Flush errors are passed to
+// onError, if not nil.
+func (c *Client) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	flush := func(ctx context.Context) {
+		if err := c.Flush(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			flush(context.Background())
+			return
+		case <-ticker.C:
+			flush(ctx)
+		}
+	}
+}
+
+// Line returns the log line of err, its JSON encoding.
+func Line(err error) ([]byte, error) {
+	if m, ok := err.(json.Marshaler); ok {
+		return m.MarshalJSON()
+	}
+	return json.Marshal(errific.ResolveChain(err))
+}
+
+// labelsKey returns a key identifying labels.
+func labelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(labels[k])
+		b.WriteByte(0)
+	}
+	return b.String()
+}
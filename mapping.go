@@ -0,0 +1,128 @@
+package errific
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+)
+
+// Mappings map error metadata to protocol codes for converters, so
+// cross-protocol consistency is configuration rather than switch statements.
+// Mappings decode from JSON with LoadMappings, and from YAML with the
+// LoadMappings of the errificyaml module.
+//
+//	{
+//		"http": {"validation": 422},
+//		"grpc": {"validation": "FAILED_PRECONDITION"},
+//		"mcp":  {"THING_001": -32602}
+//	}
+type Mappings struct {
+	// HTTP maps categories to HTTP status codes.
+	HTTP map[Category]int `json:"http,omitempty" yaml:"http,omitempty"`
+	// GRPC maps categories to gRPC status code names, such as "UNAVAILABLE".
+	GRPC map[Category]string `json:"grpc,omitempty" yaml:"grpc,omitempty"`
+	// MCP maps error codes to MCP (JSON-RPC) error codes.
+	MCP map[string]int `json:"mcp,omitempty" yaml:"mcp,omitempty"`
+}
+
+// DefaultMappings are used for categories and codes without configured Mappings.
+var DefaultMappings = Mappings{
+	HTTP: map[Category]int{
+		CategoryClient:       http.StatusBadRequest,
+		CategoryValidation:   http.StatusBadRequest,
+		CategoryNotFound:     http.StatusNotFound,
+		CategoryUnauthorized: http.StatusUnauthorized,
+		CategoryRateLimited:  http.StatusTooManyRequests,
+		CategoryTimeout:      http.StatusGatewayTimeout,
+		CategoryNetwork:      http.StatusBadGateway,
+		CategoryServer:       http.StatusInternalServerError,
+	},
+	GRPC: map[Category]string{
+		CategoryClient:       "INVALID_ARGUMENT",
+		CategoryValidation:   "INVALID_ARGUMENT",
+		CategoryNotFound:     "NOT_FOUND",
+		CategoryUnauthorized: "UNAUTHENTICATED",
+		CategoryRateLimited:  "RESOURCE_EXHAUSTED",
+		CategoryTimeout:      "DEADLINE_EXCEEDED",
+		CategoryNetwork:      "UNAVAILABLE",
+		CategoryServer:       "INTERNAL",
+	},
+	MCP: map[string]int{},
+}
+
+// LoadMappings reads JSON Mappings from the file at path.
+//
+//	m, err := errific.LoadMappings("errors.json")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	errific.Configure(errific.UseMappings(m))
+func LoadMappings(path string) (Mappings, error) {
+	var m Mappings
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+	err = json.Unmarshal(b, &m)
+	return m, err
+}
+
+type mappingsOption Mappings
+
+func (mappingsOption) ErrificOption() {}
+
+var (
+	// UseMappings for converters, overriding DefaultMappings.
+	//
+	//	errific.Configure(errific.UseMappings(m))
+	UseMappings = func(m Mappings) mappingsOption {
+		return mappingsOption(m)
+	}
+)
+
+// MapHTTPStatus returns the HTTP status set in the err chain, otherwise
+// the status mapped from its Category, otherwise 500.
+func MapHTTPStatus(err error) int {
+	return mapHTTPStatus(GetHTTPStatus(err), GetCategory(err))
+}
+
+// mapHTTPStatus returns status if set, otherwise the status mapped
+// from category, otherwise 500.
+func mapHTTPStatus(status int, category Category) int {
+	if status != 0 {
+		return status
+	}
+	if status, ok := c.mappings.HTTP[category]; ok {
+		return status
+	}
+	if status, ok := DefaultMappings.HTTP[category]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// MapGRPCCode returns the gRPC status code name mapped from the Category
+// of err, otherwise "INTERNAL".
+func MapGRPCCode(err error) string {
+	category := GetCategory(err)
+	if code, ok := c.mappings.GRPC[category]; ok {
+		return code
+	}
+	if code, ok := DefaultMappings.GRPC[category]; ok {
+		return code
+	}
+	return "INTERNAL"
+}
+
+// MapMCPCode returns the MCP error code mapped from the code of err,
+// otherwise the JSON-RPC internal error code -32603.
+func MapMCPCode(err error) int {
+	code := GetCode(err)
+	if mcp, ok := c.mappings.MCP[code]; ok {
+		return mcp
+	}
+	if mcp, ok := DefaultMappings.MCP[code]; ok {
+		return mcp
+	}
+	return -32603
+}
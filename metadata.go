@@ -0,0 +1,591 @@
+package errific
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Category classifies an error for handling decisions
+// such as status mapping and retry behavior.
+type Category string
+
+const (
+	// CategoryClient errors are caused by the caller.
+	CategoryClient Category = "client"
+	// CategoryServer errors are caused by the service itself.
+	CategoryServer Category = "server"
+	// CategoryNetwork errors are caused by transport failures.
+	CategoryNetwork Category = "network"
+	// CategoryValidation errors are caused by invalid input.
+	CategoryValidation Category = "validation"
+	// CategoryNotFound errors are caused by missing resources.
+	CategoryNotFound Category = "not_found"
+	// CategoryUnauthorized errors are caused by missing or invalid credentials.
+	CategoryUnauthorized Category = "unauthorized"
+	// CategoryTimeout errors are caused by deadlines or timeouts.
+	CategoryTimeout Category = "timeout"
+	// CategoryRateLimited errors are caused by exceeding a rate limit.
+	CategoryRateLimited Category = "rate_limited"
+)
+
+// WithCode sets a machine readable code on the error.
+//
+//	return ErrProcessThing.New(err).WithCode("THING_001")
+func (e errific) WithCode(code string) errific {
+	e.code = code
+	return e
+}
+
+// WithCategory sets the Category of the error.
+//
+//	return ErrProcessThing.New(err).WithCategory(errific.CategoryServer)
+func (e errific) WithCategory(category Category) errific {
+	e.category = category
+	return e
+}
+
+// WithCorrelationID sets an ID used to correlate the error across services.
+//
+//	return ErrProcessThing.New(err).WithCorrelationID(traceID)
+func (e errific) WithCorrelationID(id string) errific {
+	e.correlationID = id
+	return e
+}
+
+// WithRequestID sets the ID of the request the error occurred in.
+//
+//	return ErrProcessThing.New(err).WithRequestID(r.Header.Get("X-Request-Id"))
+func (e errific) WithRequestID(id string) errific {
+	e.requestID = id
+	return e
+}
+
+// WithTenant sets the tenant the error occurred for.
+//
+//	return ErrProcessThing.New(err).WithTenant("acme")
+func (e errific) WithTenant(tenant string) errific {
+	e.tenant = tenant
+	return e
+}
+
+// WithLabel sets a low cardinality key/value label on the error.
+//
+//	return ErrProcessThing.New(err).WithLabel("region", "us-east-1")
+func (e errific) WithLabel(key, value string) errific {
+	return e.WithLabels(map[string]string{key: value})
+}
+
+// WithLabels merges labels into the labels of the error.
+//
+//	return ErrProcessThing.New(err).WithLabels(map[string]string{"region": "us-east-1"})
+func (e errific) WithLabels(labels map[string]string) errific {
+	merged := make(map[string]string, len(e.labels)+len(labels))
+	for k, v := range e.labels {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	e.labels = merged
+	return e
+}
+
+// WithContext merges diagnostic key/values into the context of the error.
+// Unlike labels, context values may be high cardinality.
+//
+//	return ErrProcessThing.New(err).WithContext(map[string]any{"thing_id": id})
+func (e errific) WithContext(context map[string]any) errific {
+	merged := make(map[string]any, len(e.context)+len(context))
+	for k, v := range e.context {
+		merged[k] = v
+	}
+	for k, v := range context {
+		merged[k] = v
+	}
+	e.context = merged
+	return e
+}
+
+// Upstream identifies an error of a downstream dependency,
+// separately from the code of this service.
+type Upstream struct {
+	Service string `json:"service"`
+	Code    string `json:"code,omitempty"`
+	Status  int    `json:"status,omitempty"`
+}
+
+// WithUpstream records the service, code, and status of the dependency
+// error that caused the error, so dashboards can tell errors of this
+// service from outages of its dependencies.
+//
+//	return ErrProcessThing.New(err).WithUpstream("billing", "BILLING_503", resp.StatusCode)
+func (e errific) WithUpstream(service, code string, status int) errific {
+	e.upstream = &Upstream{Service: service, Code: code, Status: status}
+	return e
+}
+
+// Classification notes how the Category of an error was determined.
+type Classification struct {
+	// Source of the classification, such as a classifier name.
+	Source string `json:"source"`
+	// Confidence of the classification from 0 to 1.
+	Confidence float64 `json:"confidence"`
+}
+
+// WithClassification notes the source and confidence of the classification
+// of the error, so automated classifiers can mark machine assigned
+// categories and consumers can ignore low confidence ones.
+//
+//	return err.WithCategory(category).WithClassification("llm-triage", 0.62)
+func (e errific) WithClassification(source string, confidence float64) errific {
+	e.classification = &Classification{Source: source, Confidence: confidence}
+	return e
+}
+
+// Deprecation signals that the code of an error is deprecated.
+type Deprecation struct {
+	// Replacement is the code clients should handle instead.
+	Replacement string `json:"replacement,omitempty"`
+	// Sunset is when the code stops being returned.
+	Sunset time.Time `json:"sunset"`
+}
+
+// WithDeprecated marks the code of the error as deprecated in favor of
+// replacementCode, no longer returned after sunset, so clients of APIs
+// evolving their error codes know what to handle instead.
+//
+//	return ErrProcessThing.New(err).WithCode("THING_001").WithDeprecated("THING_NOT_READY", sunset)
+func (e errific) WithDeprecated(replacementCode string, sunset time.Time) errific {
+	e.deprecation = &Deprecation{Replacement: replacementCode, Sunset: sunset}
+	return e
+}
+
+// LogRef points to the location of the raw log line of an error
+// in a separately persisted log stream.
+type LogRef struct {
+	Stream string `json:"stream"`
+	Offset string `json:"offset"`
+}
+
+// WithLogRef records the stream and offset of the raw log line of the
+// error, for systems persisting logs separately, so dashboards and the
+// errific explorer can jump to the exact log location.
+//
+//	return ErrProcessThing.New(err).WithLogRef("app-logs/2026-10-16", "184467")
+func (e errific) WithLogRef(stream, offset string) errific {
+	e.logRef = &LogRef{Stream: stream, Offset: offset}
+	return e
+}
+
+// Doc references documentation of an error, with an optional
+// remediation snippet in the language Lang, such as "go" or "shell".
+type Doc struct {
+	URL     string `json:"url"`
+	Title   string `json:"title,omitempty"`
+	Snippet string `json:"snippet,omitempty"`
+	Lang    string `json:"lang,omitempty"`
+}
+
+// WithDoc adds documentation references to the error, so agent UIs and
+// developer portals can link guidance and render remediation snippets.
+//
+//	return ErrProcessThing.New(err).WithDoc(errific.Doc{
+//		URL:     "https://docs.example.com/errors/THING_001",
+//		Title:   "Retry processing",
+//		Snippet: "things retry --id 42",
+//		Lang:    "shell",
+//	})
+func (e errific) WithDoc(docs ...Doc) errific {
+	e.docs = append(e.docs[:len(e.docs):len(e.docs)], docs...)
+	return e
+}
+
+// WithCancelCause records context.Cause of ctx when ctx was cancelled with
+// a cause, as by context.WithCancelCause, so who cancelled the operation
+// and why is visible in error output. The cause satisfies errors.Is.
+// Contexts not cancelled, or without a cause, are ignored.
+// NewCtx records the cause of its ctx.
+//
+//	return ErrProcessThing.New(err).WithCancelCause(ctx)
+func (e errific) WithCancelCause(ctx context.Context) errific {
+	cause := context.Cause(ctx)
+	if cause == nil || cause == ctx.Err() {
+		return e
+	}
+	e.cancelCause = cause
+	e.unwrap = append(e.unwrap, cause)
+	return e
+}
+
+// Service identifies the service an error originated in.
+// See ServiceIdentity.
+type Service struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Env     string `json:"env,omitempty"`
+}
+
+// WithHTTPStatus sets the HTTP status code that represents the error.
+//
+//	return ErrProcessThing.New(err).WithHTTPStatus(http.StatusBadGateway)
+func (e errific) WithHTTPStatus(status int) errific {
+	e.httpStatus = status
+	return e
+}
+
+// WithRetryable marks whether the failed operation may be retried.
+//
+//	return ErrProcessThing.New(err).WithRetryable(true)
+func (e errific) WithRetryable(retryable bool) errific {
+	e.retryable = retryable
+	return e
+}
+
+// WithRetryAfter sets how long a caller should wait before retrying.
+// Setting a retry delay also marks the error as retryable.
+//
+//	return ErrProcessThing.New(err).WithRetryAfter(5 * time.Second)
+func (e errific) WithRetryAfter(d time.Duration) errific {
+	e.retryAfter = d
+	e.retryable = true
+	return e
+}
+
+// GetCode returns the code set in the err chain.
+func GetCode(err error) (code string) {
+	resolve(err, FieldCode, func(e errific) bool {
+		code = e.code
+		return code == ""
+	})
+	return code
+}
+
+// GetCategory returns the Category set in the err chain.
+func GetCategory(err error) (category Category) {
+	resolve(err, FieldCategory, func(e errific) bool {
+		category = e.category
+		return category == ""
+	})
+	return category
+}
+
+// GetCorrelationID returns the correlation ID set in the err chain.
+func GetCorrelationID(err error) (id string) {
+	resolve(err, FieldCorrelationID, func(e errific) bool {
+		id = e.correlationID
+		return id == ""
+	})
+	return id
+}
+
+// GetRequestID returns the request ID set in the err chain.
+func GetRequestID(err error) (id string) {
+	resolve(err, FieldRequestID, func(e errific) bool {
+		id = e.requestID
+		return id == ""
+	})
+	return id
+}
+
+// GetTenant returns the tenant set in the err chain.
+func GetTenant(err error) (tenant string) {
+	resolve(err, FieldTenant, func(e errific) bool {
+		tenant = e.tenant
+		return tenant == ""
+	})
+	return tenant
+}
+
+// GetLabels returns a copy of the labels set in the err chain.
+func GetLabels(err error) (labels map[string]string) {
+	resolve(err, FieldLabels, func(e errific) bool {
+		if len(e.labels) == 0 {
+			return true
+		}
+		labels = make(map[string]string, len(e.labels))
+		for k, v := range e.labels {
+			labels[k] = v
+		}
+		return false
+	})
+	return labels
+}
+
+// GetContext returns a copy of the context set in the err chain.
+func GetContext(err error) (context map[string]any) {
+	resolve(err, FieldContext, func(e errific) bool {
+		if len(e.context) == 0 {
+			return true
+		}
+		context = make(map[string]any, len(e.context))
+		for k, v := range e.context {
+			context[k] = v
+		}
+		return false
+	})
+	return context
+}
+
+// GetUpstream returns the Upstream set in the err chain, or nil.
+func GetUpstream(err error) (upstream *Upstream) {
+	resolve(err, FieldUpstream, func(e errific) bool {
+		if e.upstream == nil {
+			return true
+		}
+		u := *e.upstream
+		upstream = &u
+		return false
+	})
+	return upstream
+}
+
+// GetClassification returns the Classification set in the err chain, or nil.
+func GetClassification(err error) (classification *Classification) {
+	resolve(err, FieldClassification, func(e errific) bool {
+		if e.classification == nil {
+			return true
+		}
+		c := *e.classification
+		classification = &c
+		return false
+	})
+	return classification
+}
+
+// GetDeprecation returns the Deprecation set in the err chain, or nil.
+func GetDeprecation(err error) (deprecation *Deprecation) {
+	resolve(err, FieldDeprecation, func(e errific) bool {
+		if e.deprecation == nil {
+			return true
+		}
+		d := *e.deprecation
+		deprecation = &d
+		return false
+	})
+	return deprecation
+}
+
+// GetLogRef returns the LogRef set in the err chain, or nil.
+func GetLogRef(err error) (ref *LogRef) {
+	resolve(err, FieldLogRef, func(e errific) bool {
+		if e.logRef == nil {
+			return true
+		}
+		r := *e.logRef
+		ref = &r
+		return false
+	})
+	return ref
+}
+
+// GetDocs returns the Docs set in the err chain, or nil.
+func GetDocs(err error) (docs []Doc) {
+	resolve(err, FieldDocs, func(e errific) bool {
+		if len(e.docs) == 0 {
+			return true
+		}
+		docs = append([]Doc(nil), e.docs...)
+		return false
+	})
+	return docs
+}
+
+// GetCancelCause returns the cancellation cause recorded in the err chain
+// with WithCancelCause, or nil.
+func GetCancelCause(err error) (cause error) {
+	resolve(err, FieldCancelCause, func(e errific) bool {
+		cause = e.cancelCause
+		return cause == nil
+	})
+	return cause
+}
+
+// GetService returns the Service the err chain originated in, or nil.
+func GetService(err error) (service *Service) {
+	resolve(err, FieldService, func(e errific) bool {
+		if e.service == nil {
+			return true
+		}
+		s := *e.service
+		service = &s
+		return false
+	})
+	return service
+}
+
+// GetHTTPStatus returns the HTTP status code set in the err chain.
+func GetHTTPStatus(err error) (status int) {
+	resolve(err, FieldHTTPStatus, func(e errific) bool {
+		status = e.httpStatus
+		return status == 0
+	})
+	return status
+}
+
+// IsRetryable reports whether any error in the err chain is retryable.
+func IsRetryable(err error) (retryable bool) {
+	resolve(err, FieldRetryable, func(e errific) bool {
+		retryable = e.retryable
+		return !retryable
+	})
+	return retryable
+}
+
+// GetRetryAfter returns the retry delay set in the err chain.
+func GetRetryAfter(err error) (d time.Duration) {
+	resolve(err, FieldRetryAfter, func(e errific) bool {
+		d = e.retryAfter
+		return d == 0
+	})
+	return d
+}
+
+// GetStack returns the stack of the outermost error in the err chain
+// with one, captured with the WithStack option.
+func GetStack(err error) (stack string) {
+	walk(err, func(e errific) bool {
+		stack = string(e.stack)
+		return stack == ""
+	})
+	return stack
+}
+
+// ErrorInfo is a flattened view of the metadata on an error chain.
+// See ResolveChain.
+type ErrorInfo struct {
+	Message        string            `json:"message,omitempty"`
+	Caller         string            `json:"caller,omitempty"`
+	Code           string            `json:"code,omitempty"`
+	Category       Category          `json:"category,omitempty"`
+	CorrelationID  string            `json:"correlation_id,omitempty"`
+	RequestID      string            `json:"request_id,omitempty"`
+	Tenant         string            `json:"tenant,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Context        map[string]any    `json:"context,omitempty"`
+	Upstream       *Upstream         `json:"upstream,omitempty"`
+	Service        *Service          `json:"service,omitempty"`
+	Classification *Classification   `json:"classification,omitempty"`
+	Deprecation    *Deprecation      `json:"deprecation,omitempty"`
+	HTTPStatus     int               `json:"http_status,omitempty"`
+	Retryable      bool              `json:"retryable,omitempty"`
+	RetryAfter     Duration          `json:"retry_after,omitempty"`
+	LogRef         *LogRef           `json:"log_ref,omitempty"`
+	CancelCause    string            `json:"cancel_cause,omitempty"`
+	Expectations   []Mismatch        `json:"expectations,omitempty"`
+	Fields         map[string]any    `json:"fields,omitempty"`
+	Docs           []Doc             `json:"docs,omitempty"`
+}
+
+// Duration is a time.Duration encoded in JSON as a number of seconds,
+// such as 1.5, the unit of the Retry-After header.
+type Duration time.Duration
+
+// String returns the time.Duration string of d, such as "1.5s".
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// MarshalJSON encodes d as a number of seconds.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return appendSeconds(nil, d), nil
+}
+
+// UnmarshalJSON decodes d from a number of seconds.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	secs, err := strconv.ParseFloat(string(b), 64)
+	if err != nil {
+		return fmt.Errorf("errific: duration must be a number of seconds: %s", b)
+	}
+	*d = Duration(secs * float64(time.Second))
+	return nil
+}
+
+// appendSeconds appends the JSON number of seconds of d to b.
+func appendSeconds(b []byte, d Duration) []byte {
+	return strconv.AppendFloat(b, time.Duration(d).Seconds(), 'f', -1, 64)
+}
+
+// inherit stamps the configured ServiceIdentity on e, and copies
+// baggage metadata from the errors in a onto e when InheritMetadata
+// is configured. Metadata set on e afterwards overrides inherited values.
+func inherit(e errific, a []any) errific {
+	e.service = c.service
+
+	if !c.inheritMetadata {
+		return e
+	}
+
+	for _, v := range a {
+		err, ok := v.(error)
+		if !ok {
+			continue
+		}
+
+		if e.correlationID == "" {
+			e.correlationID = GetCorrelationID(err)
+		}
+		if e.requestID == "" {
+			e.requestID = GetRequestID(err)
+		}
+		if e.tenant == "" {
+			e.tenant = GetTenant(err)
+		}
+
+		labels := GetLabels(err)
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		for k, v := range e.labels {
+			labels[k] = v
+		}
+		if len(labels) > 0 {
+			e.labels = labels
+		}
+	}
+
+	return e
+}
+
+// walk calls fn for each errific in the err chain, outermost first,
+// until fn returns false. walk reports whether the chain was exhausted.
+func walk(err error, fn func(errific) bool) bool {
+	if err == nil {
+		return true
+	}
+
+	if e, ok := err.(errific); ok {
+		if !fn(e) {
+			return false
+		}
+
+		// range the fields directly as Unwrap allocates.
+		if !walk(e.err, fn) {
+			return false
+		}
+		for _, errs := range [][]error{e.errs, e.unwrap} {
+			for _, err := range errs {
+				if !walk(err, fn) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	switch x := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, err := range x.Unwrap() {
+			if !walk(err, fn) {
+				return false
+			}
+		}
+
+	default:
+		return walk(errors.Unwrap(err), fn)
+	}
+
+	return true
+}
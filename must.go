@@ -0,0 +1,61 @@
+package errific
+
+// ErrMissingMetadata is returned by the MustGet functions
+// when the err chain does not set the metadata.
+var ErrMissingMetadata Err = "error missing metadata"
+
+// ContextMissingField is the context key of the Field missing
+// in ErrMissingMetadata errors.
+const ContextMissingField = "missing_field"
+
+// MustGetCode returns the code set in the err chain, or an
+// ErrMissingMetadata error when no code is set, catching errors
+// that reach an API boundary unclassified.
+// With PanicOnMissingMetadata it panics instead.
+//
+//	code, err := errific.MustGetCode(err)
+func MustGetCode(err error) (string, error) {
+	code := GetCode(err)
+	if code == "" {
+		return "", missing(FieldCode, err)
+	}
+	return code, nil
+}
+
+// MustGetCategory returns the Category set in the err chain, or an
+// ErrMissingMetadata error when no Category is set.
+// With PanicOnMissingMetadata it panics instead.
+func MustGetCategory(err error) (Category, error) {
+	category := GetCategory(err)
+	if category == "" {
+		return "", missing(FieldCategory, err)
+	}
+	return category, nil
+}
+
+// MustGetHTTPStatus returns the HTTP status code set in the err chain, or an
+// ErrMissingMetadata error when no HTTP status is set.
+// With PanicOnMissingMetadata it panics instead.
+func MustGetHTTPStatus(err error) (int, error) {
+	status := GetHTTPStatus(err)
+	if status == 0 {
+		return 0, missing(FieldHTTPStatus, err)
+	}
+	return status, nil
+}
+
+// missing returns an ErrMissingMetadata error for field of err
+// with the caller of the MustGet function, or panics with it.
+func missing(field Field, err error) error {
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	e := ErrMissingMetadata.newAt(2, errs...).
+		WithContext(map[string]any{ContextMissingField: string(field)})
+	if c.panicOnMissing {
+		panic(e)
+	}
+	return e
+}
@@ -0,0 +1,48 @@
+package newrelic_test
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/newrelic"
+)
+
+type transaction struct {
+	attributes map[string]interface{}
+	noticed    error
+}
+
+func (t *transaction) NoticeError(err error) { t.noticed = err }
+
+func (t *transaction) AddAttribute(key string, value interface{}) { t.attributes[key] = value }
+
+func ExampleNoticeError() {
+	var ErrQuery errific.Err = "error querying thing"
+	txn := &transaction{attributes: map[string]interface{}{}}
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryNotFound).
+		WithCorrelationID("c-123")
+	newrelic.NoticeError(txn, err)
+
+	noticed := txn.noticed.(newrelic.Error)
+	fmt.Println(noticed.ErrorClass(), noticed.ErrorExpected())
+
+	keys := make([]string, 0, len(txn.attributes))
+	for k := range txn.attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Println(k, txn.attributes[k])
+	}
+
+	// Output:
+	// QUERY_001 true
+	// errific.category not_found
+	// errific.code QUERY_001
+	// errific.correlation_id c-123
+	// error.expected true
+}
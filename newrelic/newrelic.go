@@ -0,0 +1,114 @@
+// Package newrelic reports errific errors to New Relic transactions.
+//
+// The package depends only on the Transaction interface, so importing it
+// does not add the New Relic agent to binaries.
+// Transactions of the New Relic Go agent satisfy Transaction.
+package newrelic
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// Transaction is the subset of a New Relic transaction used by NoticeError.
+type Transaction interface {
+	NoticeError(err error)
+	AddAttribute(key string, value interface{})
+}
+
+// Error is an errific error as noticed by NoticeError. It satisfies the
+// interfaces of the New Relic agent for the class, attributes, and
+// expected classification of errors.
+type Error struct {
+	err        error
+	class      string
+	attributes map[string]interface{}
+	expected   bool
+}
+
+func (e Error) Error() string { return e.err.Error() }
+
+func (e Error) Unwrap() error { return e.err }
+
+// ErrorClass is the code of the error, or its category.
+func (e Error) ErrorClass() string { return e.class }
+
+// ErrorAttributes are the errific attributes of the error.
+func (e Error) ErrorAttributes() map[string]interface{} { return e.attributes }
+
+// ErrorExpected reports whether the error is expected, see Expected.
+func (e Error) ErrorExpected() bool { return e.expected }
+
+// NewError returns the Error of err.
+func NewError(err error) Error {
+	info := errific.ResolveChain(err)
+	e := Error{
+		err:        err,
+		class:      info.Code,
+		attributes: Attributes(err),
+		expected:   Expected(err),
+	}
+	if e.class == "" {
+		e.class = string(info.Category)
+	}
+	e.attributes["error.expected"] = e.expected
+	return e
+}
+
+// NoticeError notices err on txn with its errific attributes as custom
+// attributes of the error and the transaction, and its Expected
+// classification, so expected client errors do not count toward error rates.
+// Nil errors are ignored.
+//
+//	import errificnr "github.com/leefernandes/errific/newrelic"
+//
+//	errificnr.NoticeError(newrelic.FromContext(ctx), err)
+func NoticeError(txn Transaction, err error) {
+	if err == nil {
+		return
+	}
+
+	e := NewError(err)
+	for k, v := range e.attributes {
+		txn.AddAttribute(k, v)
+	}
+	txn.NoticeError(e)
+}
+
+// Attributes returns the errific attributes of err: code, category,
+// correlation ID, request ID, tenant, HTTP status, and retry metadata,
+// prefixed with "errific.". Unset fields are omitted.
+func Attributes(err error) map[string]interface{} {
+	info := errific.ResolveChain(err)
+	attributes := map[string]interface{}{}
+	for k, v := range map[string]string{
+		"errific.code":           info.Code,
+		"errific.category":       string(info.Category),
+		"errific.correlation_id": info.CorrelationID,
+		"errific.request_id":     info.RequestID,
+		"errific.tenant":         info.Tenant,
+	} {
+		if v != "" {
+			attributes[k] = v
+		}
+	}
+	if info.HTTPStatus != 0 {
+		attributes["errific.http_status"] = info.HTTPStatus
+	}
+	if info.Retryable {
+		attributes["errific.retryable"] = true
+	}
+	if info.RetryAfter > 0 {
+		attributes["errific.retry_after_ms"] = time.Duration(info.RetryAfter).Milliseconds()
+	}
+	return attributes
+}
+
+// Expected reports whether err is expected: retryable errors and errors
+// logged below ERROR by errific.LogLevel, such as client, validation,
+// not found, unauthorized, and rate limited errors.
+func Expected(err error) bool {
+	return errific.IsRetryable(err) || errific.LogLevel(err) < slog.LevelError
+}
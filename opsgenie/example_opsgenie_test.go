@@ -0,0 +1,27 @@
+package opsgenie_test
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/opsgenie"
+)
+
+func ExampleNewAlert() {
+	errific.Configure(errific.NoCapture, errific.LogLevelFor(errific.LevelCritical, errific.CategoryServer))
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithContext(map[string]any{"table": "things"})
+
+	a := opsgenie.NewAlert(err)
+	fmt.Println(a.Message, a.Priority, a.Alias == errific.Fingerprint(err))
+	fmt.Println(a.Tags, a.Details)
+
+	// Output:
+	// error querying thing P1 true
+	// [QUERY_001 server] map[table:things]
+}
@@ -0,0 +1,87 @@
+// Package opsgenie formats errific errors as Opsgenie alerts.
+package opsgenie
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/leefernandes/errific"
+)
+
+// Alert is an Opsgenie Alert API create alert request.
+type Alert struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Details     map[string]string `json:"details,omitempty"`
+	Entity      string            `json:"entity,omitempty"`
+	Source      string            `json:"source,omitempty"`
+	Priority    string            `json:"priority"`
+}
+
+// NewAlert returns the Alert of err.
+//
+// The alias is the errific.Fingerprint of err, so repeated errors
+// deduplicate into one alert. Priority is mapped from errific.LogLevel,
+// source and entity from the errific.ServiceIdentity, tags from the code
+// and Category, and details from the caller, labels, and context.
+//
+//	body, _ := json.Marshal(opsgenie.NewAlert(err))
+func NewAlert(err error) Alert {
+	info := errific.ResolveChain(err)
+	a := Alert{
+		Message:     errific.Truncate(info.Message, 130),
+		Alias:       errific.Fingerprint(err),
+		Description: errific.Truncate(err.Error(), 15000),
+		Source:      "errific",
+		Priority:    Priority(errific.LogLevel(err)),
+	}
+
+	if info.Code != "" {
+		a.Tags = append(a.Tags, info.Code)
+	}
+	if info.Category != "" {
+		a.Tags = append(a.Tags, string(info.Category))
+	}
+	if s := info.Service; s != nil {
+		a.Source = s.Name
+		a.Entity = s.Name
+	}
+
+	details := map[string]string{}
+	for k, v := range info.Context {
+		details[k] = fmt.Sprint(v)
+	}
+	for k, v := range info.Labels {
+		details["label."+k] = v
+	}
+	if info.Caller != "" {
+		details["caller"] = info.Caller
+	}
+	if info.CorrelationID != "" {
+		details["correlation_id"] = info.CorrelationID
+	}
+	if len(details) > 0 {
+		a.Details = details
+	}
+	sort.Strings(a.Tags)
+
+	return a
+}
+
+// Priority returns the Opsgenie priority of level: P1 for critical,
+// P2 for error, P3 for warning, and P4 otherwise.
+func Priority(level slog.Level) string {
+	switch {
+	case level >= errific.LevelCritical:
+		return "P1"
+	case level >= slog.LevelError:
+		return "P2"
+	case level >= slog.LevelWarn:
+		return "P3"
+	default:
+		return "P4"
+	}
+}
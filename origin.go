@@ -0,0 +1,129 @@
+package errific
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContextOriginExpr is the context key of the source expression of wrapped
+// errors, set with the OriginExpr option.
+const ContextOriginExpr = "origin_expr"
+
+type originExprOption bool
+
+func (originExprOption) ErrificOption() {}
+
+const (
+	// OriginExpr records the source expression producing the errors wrapped
+	// by New and NewCtx in the context of errors as origin_expr, such as
+	// validate(user), or the call assigning a wrapped variable such as err,
+	// making terse wrap sites self-describing in logs.
+	// Source files are read and parsed, once per call site, so OriginExpr
+	// is for development, with the source available where errors are created.
+	//
+	//	errific.Configure(errific.OriginExpr)
+	OriginExpr originExprOption = true
+)
+
+// origins caches the origin expressions of call sites by file:line.
+var origins sync.Map
+
+// originExpr returns the source expression of the errors wrapped by the
+// New or NewCtx call at line of file, or "" if the source is unavailable.
+func originExpr(file string, line int) string {
+	key := file + ":" + strconv.Itoa(line)
+	if expr, ok := origins.Load(key); ok {
+		return expr.(string)
+	}
+	expr := parseOriginExpr(file, line)
+	origins.Store(key, expr)
+	return expr
+}
+
+// parseOriginExpr parses file for the New or NewCtx call at line, returning
+// its error arguments as source, or the call assigned to identifier arguments
+// closest before line in the enclosing function.
+func parseOriginExpr(file string, line int) string {
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, src, 0)
+	if err != nil {
+		return ""
+	}
+
+	contains := func(n ast.Node) bool {
+		return fset.Position(n.Pos()).Line <= line && line <= fset.Position(n.End()).Line
+	}
+	source := func(n ast.Node) string {
+		return string(src[fset.Position(n.Pos()).Offset:fset.Position(n.End()).Offset])
+	}
+
+	var fn ast.Node
+	var call *ast.CallExpr
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil || !contains(n) {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			fn = n
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && (sel.Sel.Name == "New" || sel.Sel.Name == "NewCtx") {
+				call = n
+			}
+		}
+		return true
+	})
+	if call == nil {
+		return ""
+	}
+
+	args := call.Args
+	if call.Fun.(*ast.SelectorExpr).Sel.Name == "NewCtx" && len(args) > 0 {
+		args = args[1:]
+	}
+
+	exprs := make([]string, 0, len(args))
+	for _, arg := range args {
+		expr := source(arg)
+		if ident, ok := arg.(*ast.Ident); ok && fn != nil {
+			if assigned := assignedCall(fset, fn, ident.Name, line); assigned != nil {
+				expr = source(assigned)
+			}
+		}
+		exprs = append(exprs, expr)
+	}
+	return strings.Join(exprs, ", ")
+}
+
+// assignedCall returns the call assigned to name closest before line in fn.
+func assignedCall(fset *token.FileSet, fn ast.Node, name string, line int) (call *ast.CallExpr) {
+	ast.Inspect(fn, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || fset.Position(assign.Pos()).Line > line {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			if ident, ok := lhs.(*ast.Ident); !ok || ident.Name != name {
+				continue
+			}
+			rhs := assign.Rhs[0]
+			if len(assign.Rhs) == len(assign.Lhs) {
+				rhs = assign.Rhs[i]
+			}
+			if c, ok := rhs.(*ast.CallExpr); ok {
+				call = c
+			}
+		}
+		return true
+	})
+	return call
+}
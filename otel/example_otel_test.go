@@ -0,0 +1,83 @@
+package otel_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	ErrQuery  errific.Err = "error querying thing"
+	ErrHandle errific.Err = "error handling request"
+)
+
+func chain() error {
+	cause := ErrQuery.New().WithCode("QUERY_001").WithCategory(errific.CategoryTimeout)
+	return ErrHandle.New(cause).WithCode("HANDLE_001").WithCategory(errific.CategoryServer)
+}
+
+func ExampleRecordError() {
+	errific.Configure(errific.NoCapture)
+	defer errific.Configure()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("example")
+	_, span := tracer.Start(context.Background(), "handle")
+	otel.RecordError(span, chain())
+	span.End()
+
+	s := recorder.Ended()[0]
+	fmt.Println(s.Status().Code, s.Status().Description, len(s.Events()))
+	for _, attr := range s.Events()[0].Attributes {
+		fmt.Println(attr.Key, attr.Value.Emit())
+	}
+
+	// Output:
+	// Error error handling request 1
+	// errific.code HANDLE_001
+	// errific.category server
+	// error.chain.0.code HANDLE_001
+	// error.chain.0.category server
+	// error.chain.1.code QUERY_001
+	// error.chain.1.category timeout
+	// exception.type github.com/leefernandes/errific.errific
+	// exception.message error handling request
+	// error querying thing
+}
+
+func ExampleEventPerChainLevel() {
+	errific.Configure(errific.NoCapture)
+	defer errific.Configure()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("example")
+	_, span := tracer.Start(context.Background(), "handle")
+	otel.RecordError(span, chain(), otel.EventPerChainLevel)
+	span.End()
+
+	s := recorder.Ended()[0]
+	fmt.Println(len(s.Events()))
+	for _, event := range s.Events() {
+		fmt.Println(event.Name)
+		for _, attr := range event.Attributes {
+			fmt.Println(" ", attr.Key, attr.Value.Emit())
+		}
+	}
+
+	// Output:
+	// 2
+	// exception
+	//   errific.code HANDLE_001
+	//   errific.category server
+	//   errific.chain.level 0
+	//   exception.message error handling request
+	// exception
+	//   errific.code QUERY_001
+	//   errific.category timeout
+	//   errific.chain.level 1
+	//   exception.message error querying thing
+}
@@ -0,0 +1,104 @@
+// Package otel records errific errors on OpenTelemetry spans.
+//
+// It is a separate module so errific does not depend on OpenTelemetry.
+package otel
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type eventPerChainLevelOption bool
+
+func (eventPerChainLevelOption) otelOption() {}
+
+const (
+	// EventPerChainLevel records one exception event per errific error
+	// of the chain, each with its own caller and code attributes,
+	// instead of a single event for the flattened error.
+	EventPerChainLevel eventPerChainLevelOption = true
+)
+
+// Option configures RecordError.
+type Option interface {
+	otelOption()
+}
+
+// RecordError records err as an exception event on span with the
+// resolved errific metadata as attributes, including the code and
+// category of each wrapped error as error.chain.<n>.code|category,
+// and sets the span status to error. Nil errors are ignored.
+//
+//	otel.RecordError(span, err, otel.EventPerChainLevel)
+func RecordError(span trace.Span, err error, opts ...Option) {
+	if err == nil {
+		return
+	}
+
+	var perLevel bool
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case eventPerChainLevelOption:
+			perLevel = bool(o)
+		}
+	}
+
+	info := errific.ResolveChain(err)
+	span.SetStatus(codes.Error, info.Message)
+
+	chain := errific.Chain(err)
+	if !perLevel || len(chain) == 0 {
+		attrs := Attributes(info)
+		for i, level := range chain {
+			attrs = append(attrs,
+				attribute.String(fmt.Sprintf("error.chain.%d.code", i), level.Code),
+				attribute.String(fmt.Sprintf("error.chain.%d.category", i), string(level.Category)),
+			)
+		}
+		span.RecordError(err, trace.WithAttributes(attrs...))
+		return
+	}
+
+	for i, level := range chain {
+		attrs := append(Attributes(level),
+			attribute.Int("errific.chain.level", i),
+			attribute.String("exception.message", level.Message),
+		)
+		span.AddEvent("exception", trace.WithAttributes(attrs...))
+	}
+}
+
+// Attributes returns the metadata of info that is set as span attributes.
+func Attributes(info errific.ErrorInfo) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	add := func(key, value string) {
+		if value != "" {
+			attrs = append(attrs, attribute.String(key, value))
+		}
+	}
+
+	add("errific.caller", info.Caller)
+	add("errific.code", info.Code)
+	add("errific.category", string(info.Category))
+	add("errific.correlation_id", info.CorrelationID)
+	add("errific.request_id", info.RequestID)
+	add("errific.tenant", info.Tenant)
+	if info.HTTPStatus != 0 {
+		attrs = append(attrs, attribute.Int("errific.http_status", info.HTTPStatus))
+	}
+	if info.Retryable {
+		attrs = append(attrs, attribute.Bool("errific.retryable", true))
+	}
+	if info.RetryAfter > 0 {
+		attrs = append(attrs, attribute.String("errific.retry_after", info.RetryAfter.String()))
+	}
+	for k, v := range info.Labels {
+		add("errific.label."+k, v)
+	}
+
+	return attrs
+}
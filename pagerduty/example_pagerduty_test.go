@@ -0,0 +1,29 @@
+package pagerduty_test
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/pagerduty"
+)
+
+func ExampleNewEvent() {
+	errific.Configure(errific.NoCapture, errific.ServiceIdentity("things", "v1.2.3", "prod"))
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryServer).
+		WithLabels(map[string]string{"region": "us-east-1"})
+
+	e := pagerduty.NewEvent(err, "routing-key")
+	fmt.Println(e.EventAction, e.DedupKey == errific.Fingerprint(err))
+	fmt.Println(e.Payload.Summary, e.Payload.Severity, e.Payload.Source, e.Payload.Group, e.Payload.Class)
+	fmt.Println(e.Payload.CustomDetails)
+
+	// Output:
+	// trigger true
+	// error querying thing error things prod server
+	// map[code:QUERY_001 label.region:us-east-1]
+}
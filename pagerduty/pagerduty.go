@@ -0,0 +1,98 @@
+// Package pagerduty formats errific errors as PagerDuty Events API v2
+// trigger events.
+package pagerduty
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// Event is a PagerDuty Events API v2 event.
+type Event struct {
+	RoutingKey  string  `json:"routing_key"`
+	EventAction string  `json:"event_action"`
+	DedupKey    string  `json:"dedup_key"`
+	Payload     Payload `json:"payload"`
+}
+
+// Payload is the payload of a trigger Event.
+type Payload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      string         `json:"severity"`
+	Timestamp     time.Time      `json:"timestamp"`
+	Component     string         `json:"component,omitempty"`
+	Group         string         `json:"group,omitempty"`
+	Class         string         `json:"class,omitempty"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+// NewEvent returns the trigger Event of err for routingKey.
+//
+// The dedup_key is the errific.Fingerprint of err, so repeated errors
+// update one incident. Severity is mapped from errific.LogLevel, source
+// and component from the errific.ServiceIdentity, class from the Category,
+// and custom details from the code, caller, labels, and context.
+//
+//	body, _ := json.Marshal(pagerduty.NewEvent(err, routingKey))
+//	http.Post("https://events.pagerduty.com/v2/enqueue", "application/json", bytes.NewReader(body))
+func NewEvent(err error, routingKey string) Event {
+	info := errific.ResolveChain(err)
+	e := Event{
+		RoutingKey:  routingKey,
+		EventAction: "trigger",
+		DedupKey:    errific.Fingerprint(err),
+		Payload: Payload{
+			Summary:   errific.Truncate(err.Error(), 1024), // the PagerDuty limit.
+			Source:    "errific",
+			Severity:  Severity(errific.LogLevel(err)),
+			Timestamp: time.Now().UTC(),
+			Class:     string(info.Category),
+		},
+	}
+
+	if s := info.Service; s != nil {
+		e.Payload.Source = s.Name
+		e.Payload.Component = s.Name
+		e.Payload.Group = s.Env
+	}
+
+	details := map[string]any{}
+	for k, v := range info.Context {
+		details[k] = v
+	}
+	for k, v := range info.Labels {
+		details["label."+k] = v
+	}
+	if info.Code != "" {
+		details["code"] = info.Code
+	}
+	if info.Caller != "" {
+		details["caller"] = info.Caller
+	}
+	if info.CorrelationID != "" {
+		details["correlation_id"] = info.CorrelationID
+	}
+	if len(details) > 0 {
+		e.Payload.CustomDetails = details
+	}
+
+	return e
+}
+
+// Severity returns the PagerDuty severity of level:
+// critical, error, warning, or info.
+func Severity(level slog.Level) string {
+	switch {
+	case level >= errific.LevelCritical:
+		return "critical"
+	case level >= slog.LevelError:
+		return "error"
+	case level >= slog.LevelWarn:
+		return "warning"
+	default:
+		return "info"
+	}
+}
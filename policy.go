@@ -0,0 +1,156 @@
+package errific
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Action is what a Policy decides to do with an error.
+type Action string
+
+const (
+	// ActionRetry retries the failed operation.
+	ActionRetry Action = "retry"
+	// ActionEscalate reports the error to an operator or caller.
+	ActionEscalate Action = "escalate"
+	// ActionSuppress drops the error.
+	ActionSuppress Action = "suppress"
+	// ActionFallback uses the Fallback of the rule instead.
+	ActionFallback Action = "fallback"
+)
+
+// PolicyRule maps the errors it matches to an Action.
+// A rule matches errors with all of its set matchers.
+type PolicyRule struct {
+	// Name identifies the rule in Decisions.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Code of matched errors. Empty matches any code.
+	Code string `json:"code,omitempty" yaml:"code,omitempty"`
+	// Category of matched errors. Empty matches any category.
+	Category Category `json:"category,omitempty" yaml:"category,omitempty"`
+	// Labels matched errors have, with the same values.
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	// Action for matched errors.
+	Action Action `json:"action" yaml:"action"`
+	// Fallback names what to use instead for ActionFallback, such as a cache.
+	Fallback string `json:"fallback,omitempty" yaml:"fallback,omitempty"`
+}
+
+// Policy decides what to do with errors, so agents and middleware share
+// one declarative place for error handling. Policies decode from JSON,
+// and from YAML with a YAML decoder.
+//
+//	{
+//		"rules": [
+//			{"name": "cache", "code": "QUERY_001", "action": "fallback", "fallback": "cache"},
+//			{"category": "validation", "action": "suppress"},
+//			{"labels": {"tier": "critical"}, "action": "escalate"}
+//		]
+//	}
+type Policy struct {
+	// Rules are evaluated in order, the first match decides.
+	Rules []PolicyRule `json:"rules" yaml:"rules"`
+	// Default is the Action for errors no rule matches. If empty, retryable
+	// errors are retried and other errors escalated.
+	Default Action `json:"default,omitempty" yaml:"default,omitempty"`
+}
+
+// Decision is what a Policy decided to do with an error.
+type Decision struct {
+	Action Action `json:"action"`
+	// Rule is the name of the matched rule, empty if no rule matched.
+	Rule string `json:"rule,omitempty"`
+	// Fallback of the matched rule.
+	Fallback string `json:"fallback,omitempty"`
+	// RetryAfter of the error for ActionRetry.
+	RetryAfter Duration `json:"retry_after,omitempty"`
+}
+
+// Decide returns the Decision of the first rule matching err, or the
+// default Action. Nil errors decide the zero Decision.
+//
+//	switch d := policy.Decide(err); d.Action {
+//	case errific.ActionRetry:
+//		time.Sleep(time.Duration(d.RetryAfter))
+//	case errific.ActionFallback:
+//		return fallbacks[d.Fallback]()
+//	}
+func (p Policy) Decide(err error) Decision {
+	if err == nil {
+		return Decision{}
+	}
+
+	info := ResolveChain(err)
+	d := Decision{Action: p.Default}
+	for _, r := range p.Rules {
+		if r.matches(info) {
+			d = Decision{Action: r.Action, Rule: r.Name, Fallback: r.Fallback}
+			break
+		}
+	}
+	if d.Action == "" {
+		d.Action = ActionEscalate
+		if info.Retryable {
+			d.Action = ActionRetry
+		}
+	}
+	if d.Action == ActionRetry {
+		d.RetryAfter = info.RetryAfter
+	}
+	return d
+}
+
+// matches reports whether info has the set matchers of r.
+func (r PolicyRule) matches(info ErrorInfo) bool {
+	if r.Code != "" && r.Code != info.Code {
+		return false
+	}
+	if r.Category != "" && r.Category != info.Category {
+		return false
+	}
+	for k, v := range r.Labels {
+		if label, ok := info.Labels[k]; !ok || label != v {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadPolicy reads a JSON Policy from the file at path.
+//
+//	p, err := errific.LoadPolicy("policy.json")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	errific.Configure(errific.UsePolicy(p))
+func LoadPolicy(path string) (Policy, error) {
+	var p Policy
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return p, err
+	}
+	err = json.Unmarshal(b, &p)
+	return p, err
+}
+
+type policyOption Policy
+
+func (policyOption) ErrificOption() {}
+
+var (
+	// UsePolicy for Decide.
+	//
+	//	errific.Configure(errific.UsePolicy(p))
+	UsePolicy = func(p Policy) policyOption {
+		return policyOption(p)
+	}
+)
+
+// Decide returns the Decision of the configured Policy for err.
+//
+//	if errific.Decide(err).Action == errific.ActionSuppress {
+//		return nil
+//	}
+func Decide(err error) Decision {
+	return c.policy.Decide(err)
+}
@@ -0,0 +1,104 @@
+package errific
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// ContentTypeProblem is the content type of ProblemDetails responses.
+const ContentTypeProblem = "application/problem+json"
+
+// ProblemDetails is an RFC 9457 Problem Details object, with the code,
+// retry metadata, and context of the error as extension members.
+type ProblemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	Code      string `json:"code,omitempty"`
+	Retryable bool   `json:"retryable,omitempty"`
+	// RetryAfter is the retry delay in whole seconds, rounded up.
+	RetryAfter int            `json:"retry_after,omitempty"`
+	Context    map[string]any `json:"context,omitempty"`
+}
+
+type docsURLOption string
+
+func (docsURLOption) ErrificOption() {}
+
+var (
+	// DocsURL is the base URL of error code documentation, the type of
+	// ProblemDetails is the URL of the code under it.
+	//
+	//	errific.Configure(errific.DocsURL("https://docs.example.com/errors"))
+	DocsURL = func(base string) docsURLOption {
+		return docsURLOption(base)
+	}
+)
+
+// ToProblemDetails returns the ProblemDetails of err. The type is the URL
+// of its first Doc, otherwise the DocsURL of its code, otherwise "about:blank", the status is that of
+// MapHTTPStatus with its text as title, the detail is the message, and
+// the instance is the request ID.
+//
+//	p := errific.ToProblemDetails(err)
+func ToProblemDetails(err error) ProblemDetails {
+	info := ResolveChain(err)
+	status := MapHTTPStatus(err)
+	p := ProblemDetails{
+		Type:       "about:blank",
+		Title:      http.StatusText(status),
+		Status:     status,
+		Detail:     info.Message,
+		Instance:   info.RequestID,
+		Code:       info.Code,
+		Retryable:  info.Retryable,
+		RetryAfter: int((info.RetryAfter + 999_999_999) / 1_000_000_000),
+		Context:    info.Context,
+	}
+	if len(info.Docs) > 0 {
+		p.Type = info.Docs[0].URL
+	} else if c.docsURL != "" && info.Code != "" {
+		if u, err := url.JoinPath(c.docsURL, url.PathEscape(info.Code)); err == nil {
+			p.Type = u
+		}
+	}
+	return p
+}
+
+// WriteProblem writes err as an application/problem+json response of
+// its ProblemDetails, with the status and headers of WriteHTTP.
+// With ViewExternal, the context is omitted and the detail of 5xx
+// errors is the status text.
+//
+//	if err != nil {
+//		errific.WriteProblem(w, err)
+//		return
+//	}
+func WriteProblem(w http.ResponseWriter, err error) {
+	p := ToProblemDetails(err)
+	if c.httpView == ViewExternal {
+		p.Context = nil
+		if p.Status >= http.StatusInternalServerError {
+			p.Detail = p.Title
+		}
+	}
+
+	body, marshalErr := json.Marshal(p)
+	if marshalErr != nil {
+		p.Context = serializable(ErrorInfo{Context: p.Context}).Context
+		body, marshalErr = json.Marshal(p)
+	}
+	if marshalErr != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	writeHTTPHeader(w.Header(), err)
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.WriteHeader(p.Status)
+	w.Write(append(body, '\n'))
+}
@@ -0,0 +1,100 @@
+package errific
+
+import "context"
+
+// profile of formatting options bound to an Err with WithOptions.
+type profile struct {
+	caller    callerOption
+	layout    layoutOption
+	withStack withStackTraceOption
+}
+
+// Profiled is an Err with formatting options bound by Err.WithOptions.
+// Errors created with a Profiled match it with errors.Is, but not its Err,
+// nor another Profiled of the same text.
+type Profiled struct {
+	Err
+	profile *profile
+}
+
+// WithOptions binds the formatting options Suffix|Prefix|Disabled,
+// Newline|Inline, and WithStack to e, so errors created with the returned
+// Profiled format with them regardless of Configure, for libraries
+// guaranteeing the shape of their errors. Options not given are defaults,
+// other options are ignored, and the Output of Configure is not used.
+// Secrets are still masked.
+//
+//	var ErrQuery = errific.Err("mylib: error querying").WithOptions(errific.Inline)
+func (e Err) WithOptions(opts ...Option) Profiled {
+	p := &profile{caller: Suffix, layout: Newline}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case callerOption:
+			p.caller = o
+		case layoutOption:
+			p.layout = o
+		case withStackTraceOption:
+			p.withStack = o
+		}
+	}
+	return Profiled{Err: e, profile: p}
+}
+
+// New returns an error like Err.New formatted with the options of p.
+func (p Profiled) New(errs ...error) errific {
+	if c.reportCreated {
+		return p.newReportedAt(1, errs)
+	}
+	return p.newAt(1, errs...)
+}
+
+// NewCtx returns an error like Err.NewCtx formatted with the options of p.
+func (p Profiled) NewCtx(ctx context.Context, errs ...error) errific {
+	if c.reportCreated {
+		return p.newCtxReportedAt(1, ctx, errs)
+	}
+	return p.newCtxAt(1, ctx, errs...)
+}
+
+// Errorf returns an error like Err.Errorf formatted with the options of p.
+func (p Profiled) Errorf(a ...any) errific {
+	return created(p.errorfAt(1, a...))
+}
+
+// Withf returns an error like Err.Withf formatted with the options of p.
+func (p Profiled) Withf(format string, a ...any) errific {
+	return created(p.withfAt(1, format, a...))
+}
+
+// Wrapf returns an error like Err.Wrapf formatted with the options of p.
+func (p Profiled) Wrapf(format string, a ...any) errific {
+	return created(p.wrapfAt(1, format, a...))
+}
+
+// id returns the error identifying errors created with p: the Err of p
+// when no options are bound, otherwise p.
+func (p Profiled) id() error {
+	if p.profile == nil {
+		return p.Err
+	}
+	return p
+}
+
+// errText returns the Err text of err if err is an Err or Profiled.
+func errText(err error) (Err, bool) {
+	switch x := err.(type) {
+	case Err:
+		return x, true
+	case Profiled:
+		return x.Err, true
+	}
+	return "", false
+}
+
+// captureStack reports whether errors of the profile p capture stacks.
+func (p *profile) captureStack() bool {
+	if p == nil {
+		return bool(c.withStack)
+	}
+	return bool(p.withStack)
+}
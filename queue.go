@@ -0,0 +1,114 @@
+package errific
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Errs of errors returned by QueueFull and QueueTimeout.
+var (
+	ErrQueueFull    Err = "queue full"
+	ErrQueueTimeout Err = "timed out sending to queue"
+)
+
+// Codes of errors returned by QueueFull and QueueTimeout.
+const (
+	CodeQueueFull    = "QUEUE_FULL"
+	CodeQueueTimeout = "QUEUE_TIMEOUT"
+)
+
+// Context keys set by QueueFull and QueueTimeout.
+const (
+	ContextQueueName        = "queue"
+	ContextQueueDepth       = "queue_depth"
+	ContextQueueCapacity    = "queue_capacity"
+	ContextQueueUtilization = "queue_utilization"
+	ContextQueueWait        = "queue_wait"
+)
+
+// QueueRetryBase is the retry delay of queues at capacity. Retry delays
+// scale with utilization, so more saturated queues back off longer.
+var QueueRetryBase = 100 * time.Millisecond
+
+// QueueFull returns an ErrQueueFull error with CodeQueueFull,
+// CategoryServer, HTTP status 503, a retry delay of QueueRetryBase scaled
+// by the utilization of the queue, and the queue name, depth, capacity,
+// and utilization in its context.
+//
+//	if len(jobs) == cap(jobs) {
+//		return errific.QueueFull("jobs", len(jobs), cap(jobs))
+//	}
+func QueueFull(queue string, depth, capacity int) error {
+	return queueError(ErrQueueFull.newAt(1), CodeQueueFull, queue, depth, capacity)
+}
+
+// QueueTimeout returns an ErrQueueTimeout error with CodeQueueTimeout and
+// the metadata of QueueFull, and how long the send waited in its context.
+//
+//	case <-time.After(time.Second):
+//		return errific.QueueTimeout("jobs", len(jobs), cap(jobs), time.Second)
+func QueueTimeout(queue string, depth, capacity int, wait time.Duration) error {
+	e := queueError(ErrQueueTimeout.newAt(1), CodeQueueTimeout, queue, depth, capacity)
+	return e.WithContext(map[string]any{ContextQueueWait: wait})
+}
+
+// Send sends v to ch, waiting up to timeout for room. It returns a
+// QueueTimeout error if ch is still full after timeout, wrapping the
+// error of ctx if it is done first. Timeouts of zero or less do not wait,
+// returning a QueueFull error if ch is full.
+//
+//	if err := errific.Send(ctx, jobs, job, "jobs", 50*time.Millisecond); err != nil {
+//		return err
+//	}
+func Send[T any](ctx context.Context, ch chan<- T, v T, queue string, timeout time.Duration) error {
+	select {
+	case ch <- v:
+		return nil
+	default:
+	}
+	if timeout <= 0 {
+		return queueError(ErrQueueFull.newAt(1), CodeQueueFull, queue, len(ch), cap(ch))
+	}
+
+	start := time.Now()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var e errific
+	select {
+	case ch <- v:
+		return nil
+	case <-ctx.Done():
+		e = ErrQueueTimeout.newAt(1, ctx.Err())
+	case <-timer.C:
+		e = ErrQueueTimeout.newAt(1)
+	}
+	return queueError(e, CodeQueueTimeout, queue, len(ch), cap(ch)).
+		WithContext(map[string]any{ContextQueueWait: time.Since(start)})
+}
+
+func queueError(e errific, code, queue string, depth, capacity int) errific {
+	var utilization float64
+	if capacity > 0 {
+		utilization = float64(depth) / float64(capacity)
+	} else {
+		utilization = 1
+	}
+
+	e = e.WithCode(code).
+		WithCategory(CategoryServer).
+		WithHTTPStatus(http.StatusServiceUnavailable).
+		WithRetryable(true).
+		WithContext(map[string]any{
+			ContextQueueName:        queue,
+			ContextQueueDepth:       depth,
+			ContextQueueCapacity:    capacity,
+			ContextQueueUtilization: utilization,
+		})
+
+	if d := time.Duration(float64(QueueRetryBase) * utilization); d > 0 {
+		e = e.WithRetryAfter(d)
+	}
+	return e
+}
@@ -0,0 +1,90 @@
+package errific
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrRateLimited is the Err of errors returned by RateLimited.
+var ErrRateLimited Err = "rate limit exceeded"
+
+// Context keys set by RateLimited.
+const (
+	ContextRateLimitLimit     = "ratelimit_limit"
+	ContextRateLimitRemaining = "ratelimit_remaining"
+	ContextRateLimitReset     = "ratelimit_reset"
+)
+
+// Header names written by RateLimitHeader.
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRetryAfterSeconds  = "Retry-After"
+)
+
+// RateLimited returns an ErrRateLimited error with CategoryRateLimited,
+// HTTP status 429, a retry delay until reset, and the limit, remaining
+// requests, and reset time in its context.
+//
+//	if !limiter.Allow() {
+//		return errific.RateLimited(100, 0, window.End)
+//	}
+func RateLimited(limit, remaining int, reset time.Time) error {
+	e := ErrRateLimited.newAt(1).
+		WithCategory(CategoryRateLimited).
+		WithHTTPStatus(http.StatusTooManyRequests).
+		WithRetryable(true).
+		WithContext(map[string]any{
+			ContextRateLimitLimit:     limit,
+			ContextRateLimitRemaining: remaining,
+			ContextRateLimitReset:     reset,
+		})
+
+	if d := time.Until(reset); d > 0 {
+		e = e.WithRetryAfter(d)
+	}
+
+	return e
+}
+
+// RateLimitHeader returns the RateLimit-* and Retry-After headers
+// for the rate limit context of err. Reset and Retry-After are
+// in whole seconds, rounded up. Only context that is set is included.
+// WriteHTTP and WriteProblem write these headers.
+func RateLimitHeader(err error) http.Header {
+	h := http.Header{}
+	rateLimitHeader(h, err)
+	return h
+}
+
+// rateLimitHeader sets the RateLimit-* and Retry-After headers of err in h.
+func rateLimitHeader(h http.Header, err error) {
+	context := GetContext(err)
+
+	if limit, ok := context[ContextRateLimitLimit].(int); ok {
+		h.Set(HeaderRateLimitLimit, strconv.Itoa(limit))
+	}
+
+	if remaining, ok := context[ContextRateLimitRemaining].(int); ok {
+		h.Set(HeaderRateLimitRemaining, strconv.Itoa(remaining))
+	}
+
+	if reset, ok := context[ContextRateLimitReset].(time.Time); ok {
+		h.Set(HeaderRateLimitReset, strconv.Itoa(seconds(time.Until(reset))))
+	}
+
+	if d := GetRetryAfter(err); d > 0 {
+		h.Set(HeaderRetryAfterSeconds, strconv.Itoa(seconds(d)))
+	}
+}
+
+// seconds returns d in whole seconds, rounded up, and at least 0.
+func seconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
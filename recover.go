@@ -0,0 +1,109 @@
+package errific
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ErrPanic is the error of panics recovered by Recover.
+var ErrPanic Err = "panic handling request"
+
+// Context keys set by Recover.
+const (
+	ContextPanic      = "panic"
+	ContextMethod     = "http_method"
+	ContextPath       = "http_path"
+	ContextRoute      = "http_route"
+	ContextRemoteAddr = "remote_addr"
+)
+
+// CorrelationHeaders are the request headers Recover reads the
+// correlation ID of a panic from, in order.
+var CorrelationHeaders = []string{HeaderCorrelationID, "X-Correlation-Id", "X-Request-Id"}
+
+// Recover returns an http.Handler that recovers panics of next as ErrPanic
+// errors with a 500 HTTP status, annotated with the request method, path,
+// route pattern, and remote address, and the correlation ID of the request
+// headers. The query and port are not recorded, and the path is masked
+// with MaskSecrets. The error is reported with AddError and to report,
+// if not nil, and a 500 response is written if next had not written one.
+// http.ErrAbortHandler panics are not recovered.
+//
+//	http.ListenAndServe(":8080", errific.Recover(mux, func(r *http.Request, err error) {
+//		slog.Error("panic", "err", err)
+//	}))
+func Recover(next http.Handler, report func(r *http.Request, err error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler {
+				panic(v)
+			}
+
+			err := panicError(r, v)
+			AddError(r.Context(), err)
+			if report != nil {
+				report(r, err)
+			}
+			if !sw.wroteHeader {
+				http.Error(sw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		}()
+		next.ServeHTTP(sw, r)
+	})
+}
+
+// panicError returns the ErrPanic of the panic value v recovered handling r.
+func panicError(r *http.Request, v any) errific {
+	cause, ok := v.(error)
+	if !ok {
+		cause = fmt.Errorf("%v", v)
+	}
+
+	context := map[string]any{
+		ContextPanic:  mask(cause.Error()),
+		ContextMethod: sanitizeMethod(r.Method),
+		ContextPath:   mask(r.URL.Path),
+	}
+	if r.Pattern != "" {
+		context[ContextRoute] = r.Pattern
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		context[ContextRemoteAddr] = host
+	} else if r.RemoteAddr != "" {
+		context[ContextRemoteAddr] = r.RemoteAddr
+	}
+
+	e := ErrPanic.newAt(2, cause).
+		WithCategory(CategoryServer).
+		WithHTTPStatus(http.StatusInternalServerError).
+		WithContext(context)
+	for _, h := range CorrelationHeaders {
+		if id := r.Header.Get(h); id != "" {
+			e = e.WithCorrelationID(id)
+			break
+		}
+	}
+	return e
+}
+
+// sanitizeMethod returns method if it is a valid token, as clients may
+// send any method, otherwise "OTHER".
+func sanitizeMethod(method string) string {
+	if method == "" || len(method) > 16 {
+		return "OTHER"
+	}
+	for _, r := range method {
+		if !strings.ContainsRune("!#$%&'*+-.^_`|~", r) &&
+			(r < '0' || r > '9') && (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return "OTHER"
+		}
+	}
+	return method
+}
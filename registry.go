@@ -0,0 +1,190 @@
+package errific
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrUnregisteredCode is the Err of errors for codes not in a Registry.
+var ErrUnregisteredCode Err = "unregistered error code"
+
+// ContextUnregisteredCode is the context key of the code
+// of ErrUnregisteredCode errors.
+const ContextUnregisteredCode = "unregistered_code"
+
+// DefaultRegistry is the Registry of the package Register, Lookup,
+// MustErr, and NewCode functions.
+var DefaultRegistry = NewRegistry()
+
+// Register adds code to DefaultRegistry, see Registry.Register.
+//
+//	var ErrProcessThing = errific.Register("THING_001", "error processing a thing")
+func Register(code string, e Err) Err {
+	return DefaultRegistry.Register(code, e)
+}
+
+// Lookup returns the Err of code in DefaultRegistry, see Registry.Lookup.
+func Lookup(code string) (Err, bool) {
+	return DefaultRegistry.Lookup(code)
+}
+
+// MustErr returns the Err of code in DefaultRegistry, see Registry.MustErr.
+func MustErr(code string) Err {
+	e, ok := DefaultRegistry.Lookup(code)
+	if !ok {
+		panic(unregistered(code))
+	}
+	return e
+}
+
+// NewCode returns an error of code in DefaultRegistry, see Registry.NewCode.
+func NewCode(code string, errs ...error) error {
+	e, ok := DefaultRegistry.Lookup(code)
+	if !ok {
+		return unregistered(code, errs...)
+	}
+	return e.newAt(1, errs...).WithCode(code)
+}
+
+// Registry is a catalog of error codes and their Err definitions.
+// A Registry is safe for concurrent use.
+//
+//	var registry = errific.NewRegistry()
+//
+//	var ErrProcessThing = registry.Register("THING_001", "error processing a thing")
+type Registry struct {
+	mu         sync.RWMutex
+	codes      map[string]Err
+	deprecated map[string]Deprecation
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{codes: map[string]Err{}, deprecated: map[string]Deprecation{}}
+}
+
+// Register adds code to the catalog and returns e for declaring errors inline.
+func (r *Registry) Register(code string, e Err) Err {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codes[code] = e
+	return e
+}
+
+// Lookup returns the Err registered for code, so rule engines and
+// config-driven handlers can create the canonical error of a code.
+//
+//	if e, ok := registry.Lookup(rule.Code); ok {
+//		return e.New(err).WithCode(rule.Code)
+//	}
+func (r *Registry) Lookup(code string) (Err, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.codes[code]
+	return e, ok
+}
+
+// MustErr returns the Err registered for code, or panics with an
+// ErrUnregisteredCode error.
+func (r *Registry) MustErr(code string) Err {
+	e, ok := r.Lookup(code)
+	if !ok {
+		panic(unregistered(code))
+	}
+	return e
+}
+
+// NewCode returns an error of the Err registered for code, with the code
+// and wrapping errs, or an ErrUnregisteredCode error wrapping errs
+// if code is not registered, for creating errors from external inputs.
+//
+//	return registry.NewCode(msg.Code, err)
+func (r *Registry) NewCode(code string, errs ...error) error {
+	e, ok := r.Lookup(code)
+	if !ok {
+		return unregistered(code, errs...)
+	}
+	return e.newAt(1, errs...).WithCode(code)
+}
+
+// unregistered returns an ErrUnregisteredCode error for code
+// with the caller of the Registry function.
+func unregistered(code string, errs ...error) errific {
+	return ErrUnregisteredCode.newAt(2, errs...).
+		WithContext(map[string]any{ContextUnregisteredCode: code})
+}
+
+// Deprecate marks code as deprecated in favor of replacementCode
+// until sunset, for reporting by Verify.
+func (r *Registry) Deprecate(code, replacementCode string, sunset time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deprecated[code] = Deprecation{Replacement: replacementCode, Sunset: sunset}
+}
+
+// Codes returns the registered codes in sorted order.
+func (r *Registry) Codes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	codes := make([]string, 0, len(r.codes))
+	for code := range r.codes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Verification is the result of Registry.Verify.
+type Verification struct {
+	// Unregistered codes were observed but are not in the catalog.
+	Unregistered []string
+	// Unused codes are in the catalog but were not observed.
+	Unused []string
+	// Deprecated codes were observed but are deprecated.
+	Deprecated []string
+}
+
+// OK reports whether observed codes and the catalog are in sync.
+// Observed deprecated codes do not fail OK.
+func (v Verification) OK() bool {
+	return len(v.Unregistered) == 0 && len(v.Unused) == 0
+}
+
+// Verify cross-checks codes observed in tests or logs against the catalog,
+// flagging unregistered codes, dead catalog entries, and deprecated codes
+// still being emitted. Empty codes are ignored.
+//
+//	if v := registry.Verify(observed); !v.OK() {
+//		t.Errorf("unregistered: %v, unused: %v", v.Unregistered, v.Unused)
+//	}
+func (r *Registry) Verify(observedCodes []string) Verification {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var v Verification
+	observed := map[string]bool{}
+	for _, code := range observedCodes {
+		if code == "" || observed[code] {
+			continue
+		}
+		observed[code] = true
+		if _, ok := r.codes[code]; !ok {
+			v.Unregistered = append(v.Unregistered, code)
+		}
+		if _, ok := r.deprecated[code]; ok {
+			v.Deprecated = append(v.Deprecated, code)
+		}
+	}
+
+	for code := range r.codes {
+		if !observed[code] {
+			v.Unused = append(v.Unused, code)
+		}
+	}
+
+	sort.Strings(v.Unregistered)
+	sort.Strings(v.Unused)
+	sort.Strings(v.Deprecated)
+	return v
+}
@@ -0,0 +1,93 @@
+package errific
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Render writes err as a response to r in the content type negotiated
+// from its Accept header: Problem Details with WriteProblem, JSON with
+// WriteHTTP, or plain text. Requests accepting any type get JSON with the
+// json output format configured, and plain text otherwise. Plain text is
+// the message, or with ViewExternal the status text for 5xx errors.
+// Render has the signature of chi style render helpers.
+//
+//	r.Get("/things/{id}", func(w http.ResponseWriter, r *http.Request) {
+//		thing, err := getThing(r.Context(), chi.URLParam(r, "id"))
+//		if err != nil {
+//			errific.Render(w, r, err)
+//			return
+//		}
+//		render.JSON(w, r, thing)
+//	})
+func Render(w http.ResponseWriter, r *http.Request, err error) {
+	switch negotiate(r.Header.Get("Accept")) {
+	case ContentTypeProblem:
+		WriteProblem(w, err)
+	case "application/json":
+		WriteHTTP(w, err)
+	default:
+		writeText(w, err)
+	}
+}
+
+// negotiate returns the content type of error responses preferred by accept.
+func negotiate(accept string) string {
+	fallback := "text/plain"
+	if c.output == "json" {
+		fallback = "application/json"
+	}
+
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+
+		var contentType string
+		switch mediaType {
+		case ContentTypeProblem, "application/json", "text/plain":
+			contentType = mediaType
+		case "application/*":
+			contentType = "application/json"
+		case "text/*":
+			contentType = "text/plain"
+		case "*/*":
+			contentType = fallback
+		default:
+			continue
+		}
+		if q > bestQ {
+			best, bestQ = contentType, q
+		}
+	}
+
+	if best == "" {
+		return fallback
+	}
+	return best
+}
+
+// writeText writes err as a plain text response with the status and headers of WriteHTTP.
+func writeText(w http.ResponseWriter, err error) {
+	status := MapHTTPStatus(err)
+	message := ResolveChain(err).Message
+	if c.httpView == ViewExternal && status >= http.StatusInternalServerError {
+		message = http.StatusText(status)
+	}
+
+	writeHTTPHeader(w.Header(), err)
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	w.Write([]byte(message + "\n"))
+}
@@ -0,0 +1,104 @@
+package errific
+
+import (
+	"log/slog"
+	"slices"
+)
+
+// Reporter receives errors dispatched by Report, such as to send them
+// to an error tracker.
+type Reporter interface {
+	Report(err error)
+}
+
+// ReporterFunc is a Reporter calling itself.
+//
+//	errific.Configure(errific.Reporters(errific.ReporterFunc(journal.Record)))
+type ReporterFunc func(err error)
+
+// Report calls f with err.
+func (f ReporterFunc) Report(err error) {
+	f(err)
+}
+
+type reportersOption []Reporter
+
+func (reportersOption) ErrificOption() {}
+
+var (
+	// Reporters receive the errors passed to Report, in order.
+	//
+	//	errific.Configure(errific.Reporters(
+	//		errific.ReporterFunc(journal.Record),
+	//		errific.FilterReporter(pager, errific.LevelCritical),
+	//	))
+	Reporters = func(reporters ...Reporter) reportersOption {
+		return reportersOption(reporters)
+	}
+)
+
+type reportCreatedOption bool
+
+func (reportCreatedOption) ErrificOption() {}
+
+const (
+	// ReportCreated dispatches errors to the configured Reporters when
+	// created by New, NewCtx, Errorf, Withf, and Wrapf, without calls to Report.
+	// Reporters see the metadata of errors when created, such as metadata
+	// inherited from wrapped errors, but not metadata set by With* methods
+	// afterwards, so filters of ReportCreated Reporters cannot match
+	// the category of errors set after New.
+	//
+	//	errific.Configure(errific.ReportCreated, errific.Reporters(errific.ReporterFunc(journal.Record)))
+	ReportCreated reportCreatedOption = true
+)
+
+// Report dispatches err to the configured Reporters. Nil errors are ignored.
+//
+// Without ReportCreated, errors are reported when handled rather than when
+// created, as metadata such as the category is set on errors after they are
+// created, so call Report where errors are finally handled, such as in middleware.
+//
+//	if err := handle(r); err != nil {
+//		errific.Report(err)
+//	}
+func Report(err error) {
+	if err == nil {
+		return
+	}
+	for _, r := range c.reporters {
+		r.Report(err)
+	}
+}
+
+// FilterReporter returns a Reporter passing errors to r that are logged at
+// minLevel or above by LogLevel and, if categories are given, have one of them.
+//
+//	errific.FilterReporter(tracker, slog.LevelError, errific.CategoryServer, errific.CategoryNetwork)
+func FilterReporter(r Reporter, minLevel slog.Level, categories ...Category) Reporter {
+	return ReporterFunc(func(err error) {
+		if LogLevel(err) < minLevel {
+			return
+		}
+		if len(categories) > 0 && !slices.Contains(categories, GetCategory(err)) {
+			return
+		}
+		r.Report(err)
+	})
+}
+
+// created dispatches e to the configured Reporters if ReportCreated is configured.
+func created(e errific) errific {
+	if c.reportCreated {
+		reportCreated(e)
+	}
+	return e
+}
+
+// reportCreated reports a copy of e, so e is not moved to the heap
+// by its conversion to error when ReportCreated is not configured.
+//
+//go:noinline
+func reportCreated(e errific) {
+	Report(e)
+}
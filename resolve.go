@@ -0,0 +1,301 @@
+package errific
+
+// Field names a metadata field for resolution across an error chain.
+type Field string
+
+const (
+	FieldCode           Field = "code"
+	FieldCategory       Field = "category"
+	FieldCorrelationID  Field = "correlation_id"
+	FieldRequestID      Field = "request_id"
+	FieldTenant         Field = "tenant"
+	FieldLabels         Field = "labels"
+	FieldContext        Field = "context"
+	FieldUpstream       Field = "upstream"
+	FieldService        Field = "service"
+	FieldHTTPStatus     Field = "http_status"
+	FieldRetryable      Field = "retryable"
+	FieldRetryAfter     Field = "retry_after"
+	FieldClassification Field = "classification"
+	FieldDeprecation    Field = "deprecation"
+	FieldLogRef         Field = "log_ref"
+	FieldCancelCause    Field = "cancel_cause"
+	FieldDocs           Field = "docs"
+)
+
+type precedence int
+
+const (
+	// Outermost resolves a field from the outermost error that sets it.
+	// This is default.
+	Outermost precedence = iota
+	// Innermost resolves a field from the innermost error that sets it.
+	Innermost
+)
+
+type resolveOption struct {
+	precedence precedence
+	fields     []Field
+}
+
+func (resolveOption) ErrificOption() {}
+
+var (
+	// Resolve fields of the error chain with precedence Outermost|Innermost.
+	//
+	//	errific.Configure(errific.Resolve(errific.Innermost, errific.FieldCode))
+	Resolve = func(p precedence, fields ...Field) resolveOption {
+		return resolveOption{precedence: p, fields: fields}
+	}
+)
+
+// ResolveChain returns the effective metadata of the err chain.
+//
+// By default each field resolves from the outermost error that sets it,
+// so a handler can override the classification of an error it wraps.
+// Use the Resolve option to resolve fields from the innermost error instead,
+// so the root cause classification wins. Retryable is true if any error in
+// the chain is retryable. The Get* functions use the same precedence.
+//
+// Expectations are the Mismatches of all errors in the chain.
+// Message and Caller are those of the outermost errific error,
+// or the message of err for other errors.
+func ResolveChain(err error) ErrorInfo {
+	info, ok := Info(err)
+	if !ok && err != nil {
+		info.Message = mask(err.Error())
+	}
+	return info
+}
+
+// Info returns the metadata of the err chain like ResolveChain, walking
+// the chain once instead of once per field as the Get* functions do.
+// It reports false if there is no errific error in the chain.
+//
+//	if info, ok := errific.Info(err); ok {
+//		log.Println(info.Code, info.Category, info.CorrelationID)
+//	}
+func Info(err error) (info ErrorInfo, ok bool) {
+	// the chain is walked outermost first, so a field set on an outer error
+	// is kept, unless the field resolves from the innermost error.
+	set := func(field Field, isSet bool) bool {
+		return !isSet || c.precedence[field] == Innermost
+	}
+
+	var labels map[string]string
+	var context, values map[string]any
+	walk(err, func(e errific) bool {
+		if !ok {
+			ok = true
+			info.Message = messageOf(e)
+			info.Caller = e.caller
+		}
+		if e.code != "" && set(FieldCode, info.Code != "") {
+			info.Code = e.code
+		}
+		if e.category != "" && set(FieldCategory, info.Category != "") {
+			info.Category = e.category
+		}
+		if e.correlationID != "" && set(FieldCorrelationID, info.CorrelationID != "") {
+			info.CorrelationID = e.correlationID
+		}
+		if e.requestID != "" && set(FieldRequestID, info.RequestID != "") {
+			info.RequestID = e.requestID
+		}
+		if e.tenant != "" && set(FieldTenant, info.Tenant != "") {
+			info.Tenant = e.tenant
+		}
+		if len(e.labels) > 0 && set(FieldLabels, labels != nil) {
+			labels = e.labels
+		}
+		if len(e.context) > 0 && set(FieldContext, context != nil) {
+			context = e.context
+		}
+		if e.upstream != nil && set(FieldUpstream, info.Upstream != nil) {
+			info.Upstream = e.upstream
+		}
+		if e.service != nil && set(FieldService, info.Service != nil) {
+			info.Service = e.service
+		}
+		if e.classification != nil && set(FieldClassification, info.Classification != nil) {
+			info.Classification = e.classification
+		}
+		if e.deprecation != nil && set(FieldDeprecation, info.Deprecation != nil) {
+			info.Deprecation = e.deprecation
+		}
+		if e.logRef != nil && set(FieldLogRef, info.LogRef != nil) {
+			info.LogRef = e.logRef
+		}
+		if e.cancelCause != nil && set(FieldCancelCause, info.CancelCause != "") {
+			info.CancelCause = mask(e.cancelCause.Error())
+		}
+		if len(e.docs) > 0 && set(FieldDocs, info.Docs != nil) {
+			info.Docs = e.docs
+		}
+		if e.httpStatus != 0 && set(FieldHTTPStatus, info.HTTPStatus != 0) {
+			info.HTTPStatus = e.httpStatus
+		}
+		if e.retryAfter != 0 && set(FieldRetryAfter, info.RetryAfter != 0) {
+			info.RetryAfter = Duration(e.retryAfter)
+		}
+		info.Retryable = info.Retryable || e.retryable
+		if e.mismatch != nil {
+			info.Expectations = append(info.Expectations, *e.mismatch)
+		}
+		for name, v := range e.fields {
+			if _, isSet := values[name]; set(Field(name), isSet) {
+				if values == nil {
+					values = map[string]any{}
+				}
+				values[name] = v
+			}
+		}
+		return true
+	})
+
+	// copy what callers may modify.
+	if labels != nil {
+		info.Labels = make(map[string]string, len(labels))
+		for k, v := range labels {
+			info.Labels[k] = v
+		}
+	}
+	if context != nil {
+		info.Context = make(map[string]any, len(context))
+		for k, v := range context {
+			info.Context[k] = v
+		}
+	}
+	if info.Upstream != nil {
+		u := *info.Upstream
+		info.Upstream = &u
+	}
+	if info.Service != nil {
+		s := *info.Service
+		info.Service = &s
+	}
+	if info.Classification != nil {
+		c := *info.Classification
+		info.Classification = &c
+	}
+	if info.Deprecation != nil {
+		d := *info.Deprecation
+		info.Deprecation = &d
+	}
+	if info.LogRef != nil {
+		r := *info.LogRef
+		info.LogRef = &r
+	}
+	if info.Docs != nil {
+		info.Docs = append([]Doc(nil), info.Docs...)
+	}
+	if values != nil {
+		encodeFields(&info, values)
+	}
+	return info, ok
+}
+
+// Chain returns the ErrorInfo of each errific error in the err chain,
+// outermost first, with only the metadata set on that error, so inner
+// codes and categories are not lost when handlers re-wrap errors.
+//
+//	for i, info := range errific.Chain(err) {
+//		span.SetAttributes(attribute.String(fmt.Sprintf("error.chain.%d.code", i), info.Code))
+//	}
+func Chain(err error) []ErrorInfo {
+	var chain []ErrorInfo
+	walk(err, func(e errific) bool {
+		info := ErrorInfo{
+			Message:        messageOf(e),
+			Caller:         e.caller,
+			Code:           e.code,
+			Category:       e.category,
+			CorrelationID:  e.correlationID,
+			RequestID:      e.requestID,
+			Tenant:         e.tenant,
+			HTTPStatus:     e.httpStatus,
+			Retryable:      e.retryable,
+			RetryAfter:     Duration(e.retryAfter),
+			Upstream:       e.upstream,
+			Service:        e.service,
+			Classification: e.classification,
+			Deprecation:    e.deprecation,
+			LogRef:         e.logRef,
+		}
+		if e.cancelCause != nil {
+			info.CancelCause = mask(e.cancelCause.Error())
+		}
+		if e.mismatch != nil {
+			info.Expectations = []Mismatch{*e.mismatch}
+		}
+		if len(e.docs) > 0 {
+			info.Docs = append([]Doc(nil), e.docs...)
+		}
+		if len(e.labels) > 0 {
+			info.Labels = make(map[string]string, len(e.labels))
+			for k, v := range e.labels {
+				info.Labels[k] = v
+			}
+		}
+		if len(e.fields) > 0 {
+			encodeFields(&info, e.fields)
+		}
+		if len(e.context) > 0 {
+			info.Context = make(map[string]any, len(e.context))
+			for k, v := range e.context {
+				info.Context[k] = v
+			}
+		}
+		chain = append(chain, info)
+		return true
+	})
+	return chain
+}
+
+// outermost returns the message and caller of the outermost errific error
+// in the err chain, or the message of err for other errors.
+func outermost(err error) (message, caller string) {
+	if err == nil {
+		return "", ""
+	}
+
+	var e errific
+	var ok bool
+	walk(err, func(outer errific) bool {
+		e, ok = outer, true
+		return false
+	})
+	if !ok {
+		return mask(err.Error()), ""
+	}
+	return messageOf(e), e.caller
+}
+
+// messageOf returns the message of e without wrapped errors.
+func messageOf(e errific) string {
+	if text, ok := errText(e.err); ok {
+		return mask(string(text))
+	}
+	return mask(e.err.Error())
+}
+
+// resolve calls fn for each errific in the err chain in the configured
+// precedence of field, until fn returns false.
+func resolve(err error, field Field, fn func(errific) bool) {
+	if c.precedence[field] != Innermost {
+		walk(err, fn)
+		return
+	}
+
+	var chain []errific
+	walk(err, func(e errific) bool {
+		chain = append(chain, e)
+		return true
+	})
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		if !fn(chain[i]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package errific
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrHTTPResponse is the Err of errors returned by FromHTTPResponse.
+var ErrHTTPResponse Err = "upstream HTTP error response"
+
+// Context keys set by FromHTTPResponse.
+const (
+	ContextHTTPURL           = "http_url"
+	ContextHTTPStatus        = "http_status"
+	ContextResponseBody      = "response_body"
+	ContextResponseTruncated = "response_truncated"
+)
+
+// MaxResponseBody is the size in bytes response bodies are truncated
+// to in the context of FromHTTPResponse errors.
+var MaxResponseBody = 4096
+
+// RequestIDHeaders are the response headers FromHTTPResponse reads
+// the request ID of an upstream failure from, in order.
+var RequestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "X-Amz-Request-Id"}
+
+// FromHTTPResponse returns an ErrHTTPResponse error for an upstream HTTP
+// failure. The HTTP status and upstream are the status of resp, and the
+// category is classified from it, or from the X-Errific-* headers of resp.
+// 408, 429, 502, 503, and 504 responses are retryable, with the retry
+// delay of their Retry-After header. The request and correlation IDs are
+// those of the RequestIDHeaders and CorrelationHeaders, and the request
+// URL, without its query, and body, truncated to MaxResponseBody,
+// are in its context.
+//
+//	if resp.StatusCode >= 400 {
+//		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+//		return errific.FromHTTPResponse(resp, body)
+//	}
+func FromHTTPResponse(resp *http.Response, body []byte) errific {
+	e := ErrHTTPResponse.newAt(1)
+	if resp == nil {
+		return e
+	}
+
+	info := DecodeHeader(resp.Header)
+	status := resp.StatusCode
+	e = e.WithHTTPStatus(status)
+
+	service := ""
+	context := map[string]any{ContextHTTPStatus: status}
+	if req := resp.Request; req != nil && req.URL != nil {
+		service = req.URL.Host
+		u := *req.URL
+		u.RawQuery, u.Fragment, u.User = "", "", nil
+		context[ContextHTTPURL] = mask(u.String())
+	}
+	if len(body) > 0 {
+		s := string(body)
+		if truncated := Truncate(s, MaxResponseBody); truncated != s {
+			s = truncated
+			context[ContextResponseTruncated] = true
+		}
+		context[ContextResponseBody] = mask(s)
+	}
+	e = e.WithContext(context).WithUpstream(service, info.Code, status)
+
+	if info.Code != "" {
+		e = e.WithCode(info.Code)
+	}
+	if info.Category != "" {
+		e = e.WithCategory(info.Category)
+	} else if category := statusCategory(status); category != "" {
+		e = e.WithCategory(category).WithClassification("http_status", 1)
+	}
+
+	switch status {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		e = e.WithRetryable(true)
+	}
+	if info.Retryable {
+		e = e.WithRetryable(true)
+	}
+	if d := retryAfter(resp.Header.Get(HeaderRetryAfterSeconds)); d > 0 {
+		e = e.WithRetryAfter(d)
+	} else if info.RetryAfter > 0 {
+		e = e.WithRetryAfter(time.Duration(info.RetryAfter))
+	}
+
+	if info.RequestID != "" {
+		e = e.WithRequestID(info.RequestID)
+	}
+	if info.CorrelationID != "" {
+		e = e.WithCorrelationID(info.CorrelationID)
+	}
+	for _, h := range RequestIDHeaders {
+		if id := resp.Header.Get(h); id != "" {
+			e = e.WithRequestID(id)
+			break
+		}
+	}
+	for _, h := range CorrelationHeaders {
+		if id := resp.Header.Get(h); id != "" {
+			e = e.WithCorrelationID(id)
+			break
+		}
+	}
+	return e
+}
+
+// statusCategory returns the Category of an HTTP error status.
+func statusCategory(status int) Category {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return CategoryUnauthorized
+	case http.StatusNotFound, http.StatusGone:
+		return CategoryNotFound
+	case http.StatusUnprocessableEntity:
+		return CategoryValidation
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return CategoryTimeout
+	case http.StatusTooManyRequests:
+		return CategoryRateLimited
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return CategoryNetwork
+	}
+	switch {
+	case status >= 500:
+		return CategoryServer
+	case status >= 400:
+		return CategoryClient
+	}
+	return ""
+}
+
+// retryAfter parses a Retry-After header of delay seconds or an HTTP date.
+func retryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
@@ -0,0 +1,30 @@
+package rollbar_test
+
+import (
+	"fmt"
+
+	"github.com/leefernandes/errific"
+	"github.com/leefernandes/errific/rollbar"
+)
+
+func ExampleNewItem() {
+	errific.Configure(errific.ServiceIdentity("things", "v1.2.3", "prod"))
+	defer errific.Configure()
+	var ErrQuery errific.Err = "error querying thing"
+
+	err := ErrQuery.New().
+		WithCode("QUERY_001").
+		WithCategory(errific.CategoryValidation).
+		WithContext(map[string]any{rollbar.ContextUserID: "u-42"})
+
+	item := rollbar.NewItem(err, "token")
+	d := item.Data
+	fmt.Println(d.Level, d.Environment, d.CodeVersion, d.Fingerprint, d.Person.ID)
+	fmt.Println(d.Title, d.Context, d.Body.Trace.Exception.Class)
+	fmt.Println(d.Custom)
+
+	// Output:
+	// warning prod v1.2.3 QUERY_001 u-42
+	// error querying thing ExampleNewItem QUERY_001
+	// map[category:validation code:QUERY_001 user_id:u-42]
+}
@@ -0,0 +1,181 @@
+// Package rollbar formats errific errors as Rollbar items.
+package rollbar
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/leefernandes/errific"
+)
+
+// ContextUserID is the context key of the ID of the person affected by
+// an error, as errific errors have no user field.
+//
+//	return ErrProcessThing.New(err).WithContext(map[string]any{rollbar.ContextUserID: user.ID})
+const ContextUserID = "user_id"
+
+// Item is a Rollbar item of the item API.
+type Item struct {
+	AccessToken string `json:"access_token,omitempty"`
+	Data        Data   `json:"data"`
+}
+
+// Data is the data of an Item.
+type Data struct {
+	Environment string         `json:"environment"`
+	Body        Body           `json:"body"`
+	Level       string         `json:"level"`
+	Timestamp   int64          `json:"timestamp"`
+	CodeVersion string         `json:"code_version,omitempty"`
+	Platform    string         `json:"platform"`
+	Language    string         `json:"language"`
+	Context     string         `json:"context,omitempty"`
+	Person      *Person        `json:"person,omitempty"`
+	Server      *Server        `json:"server,omitempty"`
+	Custom      map[string]any `json:"custom,omitempty"`
+	Fingerprint string         `json:"fingerprint,omitempty"`
+	Title       string         `json:"title"`
+}
+
+// Body is the body of an item, with the trace of the error.
+type Body struct {
+	Trace Trace `json:"trace"`
+}
+
+// Trace is the stack trace and exception of an error.
+type Trace struct {
+	Frames    []Frame   `json:"frames"`
+	Exception Exception `json:"exception"`
+}
+
+// Frame is a stack frame of a Trace.
+type Frame struct {
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno,omitempty"`
+	Method   string `json:"method,omitempty"`
+}
+
+// Exception is the class and message of an error.
+type Exception struct {
+	Class   string `json:"class"`
+	Message string `json:"message"`
+}
+
+// Person is the person affected by an error.
+type Person struct {
+	ID string `json:"id"`
+}
+
+// Server is the server an error occurred on.
+type Server struct {
+	Host        string `json:"host,omitempty"`
+	CodeVersion string `json:"code_version,omitempty"`
+}
+
+// NewItem returns the Item of err for accessToken.
+//
+// The fingerprint is the code of err, so Rollbar groups errors by code,
+// or Rollbar's own grouping without a code. The level is mapped from
+// errific.LogLevel, the environment and code version from the
+// errific.ServiceIdentity, the person from ContextUserID, the frames from
+// errific.Frames, and custom data from the context, labels, and IDs.
+//
+//	body, _ := json.Marshal(rollbar.NewItem(err, token))
+//	http.Post("https://api.rollbar.com/api/1/item/", "application/json", bytes.NewReader(body))
+func NewItem(err error, accessToken string) Item {
+	info := errific.ResolveChain(err)
+	d := Data{
+		Environment: "production",
+		Level:       Level(errific.LogLevel(err)),
+		Timestamp:   time.Now().Unix(),
+		Platform:    "go",
+		Language:    "go",
+		Fingerprint: info.Code,
+		Title:       errific.Truncate(info.Message, 255), // the Rollbar limit.
+		Body: Body{Trace: Trace{
+			Frames: []Frame{},
+			Exception: Exception{
+				Class:   info.Code,
+				Message: err.Error(),
+			},
+		}},
+	}
+
+	if d.Body.Trace.Exception.Class == "" {
+		d.Body.Trace.Exception.Class = string(info.Category)
+	}
+	if d.Body.Trace.Exception.Class == "" {
+		d.Body.Trace.Exception.Class = "error"
+	}
+
+	// Rollbar lists frames outermost call first.
+	frames := errific.Frames(err)
+	for i := len(frames) - 1; i >= 0; i-- {
+		d.Body.Trace.Frames = append(d.Body.Trace.Frames, Frame{
+			Filename: frames[i].File,
+			Lineno:   frames[i].Line,
+			Method:   frames[i].Function,
+		})
+	}
+	if len(frames) > 0 {
+		d.Context = frames[0].Function
+	}
+
+	host, _ := os.Hostname()
+	d.Server = &Server{Host: host}
+	if s := info.Service; s != nil {
+		if s.Env != "" {
+			d.Environment = s.Env
+		}
+		d.CodeVersion = s.Version
+		d.Server.CodeVersion = s.Version
+	}
+
+	if id, ok := info.Context[ContextUserID]; ok {
+		if id, ok := id.(string); ok && id != "" {
+			d.Person = &Person{ID: id}
+		}
+	}
+
+	custom := map[string]any{}
+	for k, v := range info.Context {
+		custom[k] = v
+	}
+	if len(info.Labels) > 0 {
+		custom["labels"] = info.Labels
+	}
+	for k, v := range map[string]string{
+		"code":           info.Code,
+		"category":       string(info.Category),
+		"correlation_id": info.CorrelationID,
+		"request_id":     info.RequestID,
+		"tenant":         info.Tenant,
+	} {
+		if v != "" {
+			custom[k] = v
+		}
+	}
+	if len(custom) > 0 {
+		d.Custom = custom
+	}
+
+	return Item{AccessToken: accessToken, Data: d}
+}
+
+// Level returns the Rollbar level of level:
+// critical, error, warning, info, or debug.
+func Level(level slog.Level) string {
+	switch {
+	case level >= errific.LevelCritical:
+		return "critical"
+	case level >= slog.LevelError:
+		return "error"
+	case level >= slog.LevelWarn:
+		return "warning"
+	case level >= slog.LevelInfo:
+		return "info"
+	default:
+		return "debug"
+	}
+}
@@ -0,0 +1,96 @@
+package errific
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Context keys set by the finisher of BeginScope.
+const (
+	ContextScope         = "scope"
+	ContextScopeDuration = "scope_duration"
+)
+
+type scopeKey struct{}
+
+type scope struct {
+	parent    *scope
+	operation string
+	start     time.Time
+
+	mu      sync.Mutex
+	context map[string]any
+}
+
+// BeginScope begins a scope of operation, returning a context carrying it
+// and a finisher. The finisher wraps non-nil errors with an error using
+// operation as text, with the operation names of the enclosing scopes
+// joined with " > ", the duration of the scope, and the fields added with
+// AddScopeContext to the scope and enclosing scopes in its context, and
+// the cancellation cause of the scope context with WithCancelCause.
+// Nil errors are returned as is.
+//
+//	ctx, finish := errific.BeginScope(ctx, "import users")
+//	defer func() { err = finish(err) }()
+func BeginScope(ctx context.Context, operation string) (context.Context, func(error) error) {
+	parent, _ := ctx.Value(scopeKey{}).(*scope)
+	s := &scope{parent: parent, operation: operation, start: time.Now()}
+	ctx = context.WithValue(ctx, scopeKey{}, s)
+
+	finish := func(err error) error {
+		if err == nil {
+			return nil
+		}
+		fields := s.fields()
+		fields[ContextScope] = s.path()
+		fields[ContextScopeDuration] = time.Since(s.start)
+		return Err(operation).newAt(1, err).WithContext(fields).WithCancelCause(ctx)
+	}
+	return ctx, finish
+}
+
+// AddScopeContext adds fields to the scope of ctx begun with BeginScope,
+// for errors of the scope and scopes nested in it.
+// Contexts without a scope are ignored.
+//
+//	errific.AddScopeContext(ctx, map[string]any{"batch": n})
+func AddScopeContext(ctx context.Context, fields map[string]any) {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.context == nil {
+		s.context = map[string]any{}
+	}
+	for k, v := range fields {
+		s.context[k] = v
+	}
+}
+
+// fields returns the context of s and its enclosing scopes,
+// with fields of inner scopes overriding outer ones.
+func (s *scope) fields() map[string]any {
+	fields := map[string]any{}
+	if s.parent != nil {
+		fields = s.parent.fields()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.context {
+		fields[k] = v
+	}
+	return fields
+}
+
+// path returns the operations of the enclosing scopes and s joined with " > ".
+func (s *scope) path() string {
+	var operations []string
+	for ; s != nil; s = s.parent {
+		operations = append([]string{s.operation}, operations...)
+	}
+	return strings.Join(operations, " > ")
+}
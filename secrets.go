@@ -0,0 +1,22 @@
+package errific
+
+import "regexp"
+
+// Masked replaces secrets in error messages configured with MaskSecrets.
+const Masked = "[MASKED]"
+
+// SecretPatterns are the patterns masked by MaskSecrets without patterns:
+// AWS access key IDs, bearer tokens, and PEM private key blocks.
+var SecretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`),
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+}
+
+// mask replaces matches of the configured secret patterns in s.
+func mask(s string) string {
+	for _, pattern := range c.secrets {
+		s = pattern.ReplaceAllString(s, Masked)
+	}
+	return s
+}
@@ -0,0 +1,100 @@
+package errific
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"sort"
+)
+
+// ShadowDiff compares the Error() output of an error in the current
+// output format with its output in a candidate format.
+type ShadowDiff struct {
+	// Format is the current output format, empty for the default text output.
+	Format    string
+	Candidate string
+	Current   string
+	Rendered  string
+	// Missing are the JSON fields of the current output not in the
+	// candidate output, and Added those only in the candidate output.
+	// Outputs other than JSON objects have no fields.
+	Missing []string
+	Added   []string
+}
+
+// SizeDelta returns the size in bytes of the candidate output
+// less the size of the current output.
+func (d ShadowDiff) SizeDelta() int {
+	return len(d.Rendered) - len(d.Current)
+}
+
+type shadowOption struct {
+	candidate string
+	rate      float64
+	report    func(ShadowDiff)
+}
+
+func (*shadowOption) ErrificOption() {}
+
+var (
+	// Shadow renders a sample of errors, at rate from 0 to 1, in the
+	// registered candidate format as well as the configured output format
+	// when Error() is called, reporting the comparison to report, so
+	// migrations of log formats can be verified before switching.
+	//
+	//	errific.Configure(errific.OutputNamed("json"), errific.Shadow("logfmt", 0.01, func(d errific.ShadowDiff) {
+	//		slog.Info("shadow format", "missing", d.Missing, "size_delta", d.SizeDelta())
+	//	}))
+	Shadow = func(candidate string, rate float64, report func(ShadowDiff)) *shadowOption {
+		return &shadowOption{candidate: candidate, rate: rate, report: report}
+	}
+)
+
+// shadow reports the ShadowDiff of the current output of e for a sample of errors.
+func shadow(e errific, current string) {
+	s := c.shadow
+	if s.report == nil || s.rate <= 0 || (s.rate < 1 && rand.Float64() >= s.rate) {
+		return
+	}
+
+	formats.RLock()
+	marshal, ok := formats.marshal[s.candidate]
+	formats.RUnlock()
+	if !ok {
+		return
+	}
+	b, err := marshal(formatInfo(e))
+	if err != nil {
+		return
+	}
+
+	d := ShadowDiff{
+		Format:    string(c.output),
+		Candidate: s.candidate,
+		Current:   current,
+		Rendered:  mask(string(b)),
+	}
+	d.Missing, d.Added = diffFields(d.Current, d.Rendered)
+	s.report(d)
+}
+
+// diffFields returns the fields of the JSON object current that are not
+// in the JSON object candidate, and those of candidate not in current.
+func diffFields(current, candidate string) (missing, added []string) {
+	var a, b map[string]json.RawMessage
+	json.Unmarshal([]byte(current), &a)
+	json.Unmarshal([]byte(candidate), &b)
+
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(added)
+	return missing, added
+}
@@ -0,0 +1,150 @@
+package errific
+
+import (
+	"encoding/json"
+	"math/bits"
+	"sort"
+	"sync"
+)
+
+// ShapeBuckets are the upper bounds of the Distribution buckets,
+// powers of two up to 64KiB.
+var ShapeBuckets = func() []uint64 {
+	buckets := make([]uint64, 17)
+	for i := range buckets {
+		buckets[i] = 1 << i
+	}
+	return buckets
+}()
+
+// Distribution is a histogram of observed values.
+type Distribution struct {
+	Count uint64 `json:"count"`
+	Sum   uint64 `json:"sum"`
+	Max   uint64 `json:"max"`
+	// Buckets count the values up to the ShapeBuckets bound of the same
+	// index, and above the last bound in the last bucket.
+	Buckets []uint64 `json:"buckets"`
+}
+
+// observe adds v to d.
+func (d *Distribution) observe(v uint64) {
+	if d.Buckets == nil {
+		d.Buckets = make([]uint64, len(ShapeBuckets)+1)
+	}
+	d.Count++
+	d.Sum += v
+	d.Max = max(d.Max, v)
+
+	// bounds are powers of two, so the bucket is the bit length of v-1.
+	i := 0
+	if v > 1 {
+		i = bits.Len64(v - 1)
+	}
+	d.Buckets[min(i, len(ShapeBuckets))]++
+}
+
+// Mean returns the mean of the observed values.
+func (d Distribution) Mean() float64 {
+	if d.Count == 0 {
+		return 0
+	}
+	return float64(d.Sum) / float64(d.Count)
+}
+
+// Shape is the distribution of the metadata sizes of errors with a code.
+type Shape struct {
+	Code string `json:"code"`
+	// ContextSize is the number of context entries.
+	ContextSize Distribution `json:"context_size"`
+	// Depth is the chain depth, as by Depth.
+	Depth Distribution `json:"depth"`
+	// Bytes is the size of the JSON encoding, as by SerializedSize.
+	Bytes Distribution `json:"bytes"`
+}
+
+// Shapes records the metadata sizes of errors by code in a bounded set
+// of slots, so teams can find which error sites bloat logs and need
+// trimming. Codes seen after all slots are taken are recorded under Overflow.
+// Shapes is an expvar.Var, and is safe for concurrent use.
+//
+//	var shapes = errific.NewShapes(256)
+//	expvar.Publish("errific_shapes", shapes)
+//
+//	shapes.Record(err)
+type Shapes struct {
+	mu    sync.Mutex
+	slots []Shape
+	codes codeSlots
+}
+
+// NewShapes returns Shapes with slots for size distinct codes,
+// plus one slot for Overflow.
+func NewShapes(size int) *Shapes {
+	if size < 1 {
+		size = 1
+	}
+	return &Shapes{
+		slots: make([]Shape, 0, size+1),
+		codes: newCodeSlots(size),
+	}
+}
+
+// Record observes the context size, chain depth, and serialized size
+// of err under its code. Nil errors are ignored.
+func (s *Shapes) Record(err error) {
+	if err == nil {
+		return
+	}
+
+	code := GetCode(err)
+	context := uint64(len(GetContext(err)))
+	depth := uint64(Depth(err))
+	size := uint64(SerializedSize(err))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, code, added := s.codes.slot(code)
+	if added {
+		s.slots = append(s.slots, Shape{Code: code})
+	}
+
+	shape := &s.slots[i]
+	shape.ContextSize.observe(context)
+	shape.Depth.observe(depth)
+	shape.Bytes.observe(size)
+}
+
+// Shapes returns a copy of the shapes sorted by total serialized bytes,
+// highest first.
+func (s *Shapes) Shapes() []Shape {
+	s.mu.Lock()
+	shapes := make([]Shape, len(s.slots))
+	for i, shape := range s.slots {
+		shape.ContextSize.Buckets = append([]uint64(nil), shape.ContextSize.Buckets...)
+		shape.Depth.Buckets = append([]uint64(nil), shape.Depth.Buckets...)
+		shape.Bytes.Buckets = append([]uint64(nil), shape.Bytes.Buckets...)
+		shapes[i] = shape
+	}
+	s.mu.Unlock()
+
+	sort.SliceStable(shapes, func(i, j int) bool {
+		return shapes[i].Bytes.Sum > shapes[j].Bytes.Sum
+	})
+	return shapes
+}
+
+// String returns the shapes as JSON, for expvar.
+func (s *Shapes) String() string {
+	b, _ := json.Marshal(s.Shapes())
+	return string(b)
+}
+
+// Reset clears all shapes, keeping the allocated slots.
+func (s *Shapes) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slots = s.slots[:0]
+	s.codes.reset()
+}
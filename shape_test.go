@@ -0,0 +1,30 @@
+package errific_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/leefernandes/errific"
+)
+
+// TestShapesOverflow asserts Shapes keeps at most size codes plus Overflow,
+// however many codes are recorded.
+func TestShapesOverflow(t *testing.T) {
+	const size = 4
+	shapes := NewShapes(size)
+	var ErrShape Err = "shaped"
+
+	for i := range 5 * size {
+		shapes.Record(ErrShape.New().WithCode(fmt.Sprintf("CODE_%03d", i)))
+	}
+
+	got := shapes.Shapes()
+	if len(got) != size+1 {
+		t.Fatalf("got %d shapes, want %d", len(got), size+1)
+	}
+	for _, shape := range got {
+		if shape.Code == Overflow && shape.Depth.Count != 4*size {
+			t.Errorf("got %s recorded %d, want %d", Overflow, shape.Depth.Count, 4*size)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package errific
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type dropPolicy int
+
+const (
+	// Block applies backpressure, Publish waits until every matching
+	// subscriber has room, is canceled, or the Stream is closed.
+	// This is default.
+	Block dropPolicy = iota
+	// DropNewest discards the published error for subscribers that are full.
+	DropNewest
+	// DropOldest discards the oldest buffered error for subscribers that are full.
+	DropOldest
+)
+
+// Stream fans published errors out to subscribers as ErrorInfo,
+// decoupling consumers such as metrics, reporters, and journals
+// from where errors are created.
+// A Stream is safe for concurrent use.
+//
+//	var stream = errific.NewStream(64, errific.DropOldest)
+//
+//	infos, cancel := stream.Subscribe(nil)
+//	defer cancel()
+//
+//	stream.Publish(err)
+type Stream struct {
+	mu      sync.RWMutex
+	size    int
+	policy  dropPolicy
+	subs    []*subscriber
+	closed  bool
+	dropped atomic.Uint64
+
+	// done is closed by Close before taking mu, unblocking sends.
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type subscriber struct {
+	filter func(ErrorInfo) bool
+	ch     chan ErrorInfo
+
+	// done is closed by cancel before taking mu, unblocking sends.
+	done       chan struct{}
+	cancelOnce sync.Once
+}
+
+// NewStream returns a Stream whose subscriber channels buffer size errors,
+// handling full subscribers with policy Block|DropNewest|DropOldest.
+func NewStream(size int, policy dropPolicy) *Stream {
+	if size < 0 {
+		size = 0
+	}
+	return &Stream{size: size, policy: policy, done: make(chan struct{})}
+}
+
+// Subscribe returns a channel receiving the ErrorInfo of published errors
+// that match filter, and a function canceling the subscription.
+// A nil filter matches all errors. The channel is closed by cancel or Close.
+// Cancel is idempotent.
+func (s *Stream) Subscribe(filter func(ErrorInfo) bool) (<-chan ErrorInfo, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &subscriber{filter: filter, ch: make(chan ErrorInfo, s.size), done: make(chan struct{})}
+	if s.closed {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+
+	s.subs = append(s.subs, sub)
+	return sub.ch, func() { s.cancel(sub) }
+}
+
+// cancel removes sub from the subscribers and closes its channel.
+func (s *Stream) cancel(sub *subscriber) {
+	sub.cancelOnce.Do(func() { close(sub.done) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, x := range s.subs {
+		if x == sub {
+			s.subs = append(s.subs[:i:i], s.subs[i+1:]...)
+			close(sub.ch)
+			return
+		}
+	}
+}
+
+// Publish sends the ErrorInfo of err to matching subscribers.
+// Nil errors and errors published after Close are ignored.
+//
+// With Block a subscriber that stops receiving stalls Publish until
+// it receives again, is canceled, or the Stream is closed.
+func (s *Stream) Publish(err error) {
+	if err == nil {
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed || len(s.subs) == 0 {
+		return
+	}
+
+	info := ResolveChain(err)
+	for _, sub := range s.subs {
+		if sub.filter != nil && !sub.filter(info) {
+			continue
+		}
+		s.send(sub, info)
+	}
+}
+
+func (s *Stream) send(sub *subscriber, info ErrorInfo) {
+	ch := sub.ch
+	switch s.policy {
+	case DropNewest:
+		select {
+		case ch <- info:
+		default:
+			s.dropped.Add(1)
+		}
+
+	case DropOldest:
+		for {
+			select {
+			case ch <- info:
+				return
+			default:
+			}
+
+			// unbuffered channels have nothing to discard.
+			if cap(ch) == 0 {
+				s.dropped.Add(1)
+				return
+			}
+
+			select {
+			case <-ch:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+
+	default:
+		select {
+		case ch <- info:
+		case <-sub.done:
+		case <-s.done:
+		}
+	}
+}
+
+// Dropped returns the number of errors discarded by the drop policy.
+func (s *Stream) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close closes all subscriber channels, unblocking pending Publish calls.
+// Close is idempotent.
+func (s *Stream) Close() {
+	s.closeOnce.Do(func() {
+		if s.done != nil {
+			close(s.done)
+		}
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	for _, sub := range s.subs {
+		close(sub.ch)
+	}
+	s.subs = nil
+}
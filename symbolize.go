@@ -0,0 +1,144 @@
+package errific
+
+import (
+	"bufio"
+	"debug/elf"
+	"debug/gosym"
+	"debug/macho"
+	"debug/pe"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// pcPrefix prefixes the program counter offsets of PCStack frames.
+const pcPrefix = "pc"
+
+// symbolAnchor is the function program counters are relative to,
+// so offsets survive address space layout randomization.
+func symbolAnchor() {}
+
+const symbolAnchorName = "github.com/leefernandes/errific.symbolAnchor"
+
+var anchorPC = reflect.ValueOf(symbolAnchor).Pointer()
+
+// pcFrame formats pc as a stack frame offset from symbolAnchor.
+func pcFrame(pc uintptr) string {
+	return fmt.Sprintf("\n  %s%+#x", pcPrefix, int64(pc)-int64(anchorPC))
+}
+
+// Symbolize resolves the frames of a stack captured with PCStack to
+// file:line frames, using symbolFile, an ELF, Mach-O, or PE build of the
+// same binary with symbols. Other lines of stack are kept as they are.
+//
+//	stack, err := errific.Symbolize(stack, "bin/server.debug")
+func Symbolize(stack, symbolFile string) (string, error) {
+	table, err := symbolTable(symbolFile)
+	if err != nil {
+		return "", err
+	}
+
+	anchor := table.LookupFunc(symbolAnchorName)
+	if anchor == nil {
+		return "", fmt.Errorf("errific: %s has no %s symbol", symbolFile, symbolAnchorName)
+	}
+
+	var b strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(stack))
+	for i := 0; scanner.Scan(); i++ {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		line := scanner.Text()
+		b.WriteString(symbolizeLine(table, anchor.Entry, line))
+	}
+	return b.String(), scanner.Err()
+}
+
+// symbolizeLine resolves line if it is a PCStack frame.
+func symbolizeLine(table *gosym.Table, anchor uint64, line string) string {
+	indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	offset, ok := strings.CutPrefix(strings.TrimSpace(line), pcPrefix)
+	if !ok {
+		return line
+	}
+	n, err := strconv.ParseInt(offset, 0, 64)
+	if err != nil {
+		return line
+	}
+
+	pc := uint64(int64(anchor) + n)
+	file, lineNo, fn := table.PCToLine(pc)
+	if fn == nil {
+		return line
+	}
+	return indent + parseFrame(runtime.Frame{File: file, Line: lineNo, Function: fn.Name})
+}
+
+// symbolTable reads the Go line table of the executable at path.
+func symbolTable(path string) (*gosym.Table, error) {
+	var pclntab []byte
+	var text uint64
+
+	if f, err := elf.Open(path); err == nil {
+		defer f.Close()
+		if s := f.Section(".gopclntab"); s != nil {
+			pclntab, _ = s.Data()
+		}
+		if s := f.Section(".text"); s != nil {
+			text = s.Addr
+		}
+	} else if f, err := macho.Open(path); err == nil {
+		defer f.Close()
+		if s := f.Section("__gopclntab"); s != nil {
+			pclntab, _ = s.Data()
+		}
+		if s := f.Section("__text"); s != nil {
+			text = s.Addr
+		}
+	} else if f, err := pe.Open(path); err == nil {
+		defer f.Close()
+		if s := f.Section(".text"); s != nil {
+			text = uint64(s.VirtualAddress)
+			if oh, ok := f.OptionalHeader.(*pe.OptionalHeader64); ok {
+				text += oh.ImageBase
+			} else if oh, ok := f.OptionalHeader.(*pe.OptionalHeader32); ok {
+				text += uint64(oh.ImageBase)
+			}
+		}
+		pclntab = pePclntab(f)
+	} else {
+		return nil, fmt.Errorf("errific: %s is not an ELF, Mach-O, or PE executable", path)
+	}
+
+	if len(pclntab) == 0 {
+		return nil, errors.New("errific: no Go line table in " + path)
+	}
+	return gosym.NewTable(nil, gosym.NewLineTable(pclntab, text))
+}
+
+// pePclntab returns the Go line table of a PE executable,
+// located between the runtime.pclntab and runtime.epclntab symbols.
+func pePclntab(f *pe.File) []byte {
+	var start, end uint32 = 0, 0
+	var section int16
+	for _, s := range f.Symbols {
+		switch s.Name {
+		case "runtime.pclntab":
+			start, section = s.Value, s.SectionNumber
+		case "runtime.epclntab":
+			end = s.Value
+		}
+	}
+	if section < 1 || int(section) > len(f.Sections) || end <= start {
+		return nil
+	}
+	data, err := f.Sections[section-1].Data()
+	if err != nil || int(end) > len(data) {
+		return nil
+	}
+	return data[start:end]
+}
@@ -0,0 +1,164 @@
+package errific
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// OutputSyslog formats Error() output as an RFC 5424 syslog message,
+	// with the code, category, IDs, and labels as structured data,
+	// for classic syslog pipelines.
+	//
+	//	errific.Configure(errific.OutputSyslog)
+	OutputSyslog outputOption = "syslog"
+)
+
+// Structured data IDs of syslog messages, using the RFC 5612
+// private enterprise number reserved for documentation.
+const (
+	SyslogErrorID  = "errific@32473"
+	SyslogLabelsID = "labels@32473"
+)
+
+// SyslogFacility of syslog messages, user-level by default.
+var SyslogFacility = 1
+
+func init() {
+	RegisterFormat(string(OutputSyslog), marshalSyslog)
+}
+
+// marshalSyslog encodes info as an RFC 5424 message. The severity is
+// that of the levelOf info, the app name its service, and the message ID its code.
+func marshalSyslog(info ErrorInfo) ([]byte, error) {
+	var app string
+	if info.Service != nil {
+		app = info.Service.Name
+	}
+	hostname, _ := os.Hostname()
+
+	var b strings.Builder
+	b.WriteByte('<')
+	b.WriteString(strconv.Itoa(SyslogFacility*8 + syslogSeverity(levelOf(info.Category, info.HTTPStatus))))
+	b.WriteString(">1 ")
+	b.WriteString(time.Now().UTC().Format("2006-01-02T15:04:05.000000Z"))
+	for _, field := range []struct {
+		value string
+		max   int
+	}{
+		{hostname, 255},
+		{app, 48},
+		{strconv.Itoa(os.Getpid()), 128},
+		{info.Code, 32},
+	} {
+		b.WriteByte(' ')
+		b.WriteString(syslogHeader(field.value, field.max))
+	}
+	b.WriteByte(' ')
+
+	elements := 0
+	element := func(id string, params [][2]string) {
+		var n int
+		for _, p := range params {
+			if p[1] == "" {
+				continue
+			}
+			if n == 0 {
+				b.WriteByte('[')
+				b.WriteString(id)
+			}
+			n++
+			b.WriteByte(' ')
+			b.WriteString(syslogName(p[0]))
+			b.WriteString(`="`)
+			b.WriteString(syslogValue(p[1]))
+			b.WriteByte('"')
+		}
+		if n > 0 {
+			b.WriteByte(']')
+			elements++
+		}
+	}
+
+	element(SyslogErrorID, [][2]string{
+		{"code", info.Code},
+		{"category", string(info.Category)},
+		{"correlation_id", info.CorrelationID},
+		{"request_id", info.RequestID},
+		{"tenant", info.Tenant},
+		{"caller", info.Caller},
+	})
+	labels := make([][2]string, 0, len(info.Labels))
+	for _, k := range sortedKeys(info.Labels) {
+		labels = append(labels, [2]string{k, info.Labels[k]})
+	}
+	element(SyslogLabelsID, labels)
+	if elements == 0 {
+		b.WriteByte('-')
+	}
+
+	if info.Message != "" {
+		b.WriteByte(' ')
+		b.WriteString(info.Message)
+	}
+	return []byte(b.String()), nil
+}
+
+// syslogSeverity returns the syslog severity of level:
+// critical, error, warning, informational, or debug.
+func syslogSeverity(level slog.Level) int {
+	switch {
+	case level >= LevelCritical:
+		return 2
+	case level >= slog.LevelError:
+		return 3
+	case level >= slog.LevelWarn:
+		return 4
+	case level >= slog.LevelInfo:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// syslogHeader returns s as a header field of at most max printable
+// ASCII characters, or the nil value "-" if empty.
+func syslogHeader(s string, max int) string {
+	s = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return -1
+		}
+		return r
+	}, s)
+	if len(s) > max {
+		s = s[:max]
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// syslogName returns s as a structured data parameter name, at most 32
+// printable ASCII characters other than '=', ' ', ']', and '"'.
+func syslogName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, s)
+	if len(s) > 32 {
+		s = s[:32]
+	}
+	return s
+}
+
+// syslogValue returns s as a structured data parameter value,
+// escaping '"', '\', and ']'.
+func syslogValue(s string) string {
+	return strings.NewReplacer(`"`, `\"`, `\`, `\\`, `]`, `\]`).Replace(s)
+}
@@ -0,0 +1,202 @@
+package errific
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Ticket is an issue tracker ticket for errors with the same Fingerprint.
+type Ticket struct {
+	Fingerprint string            `json:"fingerprint"`
+	Message     string            `json:"message"`
+	Caller      string            `json:"caller,omitempty"`
+	Code        string            `json:"code,omitempty"`
+	Category    Category          `json:"category,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	// Count is the number of occurrences since the ticket was last sent.
+	Count     uint64    `json:"count"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// Title returns a ticket title of the code and message of t.
+func (t Ticket) Title() string {
+	if t.Code == "" {
+		return t.Message
+	}
+	return fmt.Sprintf("[%s] %s", t.Code, t.Message)
+}
+
+// TicketSink creates the ticket of a fingerprint, or updates it if one exists.
+type TicketSink interface {
+	Upsert(ctx context.Context, t Ticket) error
+}
+
+// TicketSinkFunc adapts a function to a TicketSink.
+type TicketSinkFunc func(ctx context.Context, t Ticket) error
+
+// Upsert calls f.
+func (f TicketSinkFunc) Upsert(ctx context.Context, t Ticket) error {
+	return f(ctx, t)
+}
+
+// Ticketer sends errors as tickets keyed by Fingerprint to a TicketSink,
+// at most once per fingerprint within a dedup window, with the count of
+// occurrences since the ticket was last sent.
+// A Ticketer is safe for concurrent use.
+//
+//	ticketer := errific.NewTicketer(errific.WebhookTicketSink(url, errific.GitHubIssue, nil), time.Hour)
+//
+//	ticketer.Report(ctx, err)
+type Ticketer struct {
+	sink   TicketSink
+	window time.Duration
+
+	mu      sync.Mutex
+	tickets map[string]*ticketState
+}
+
+type ticketState struct {
+	ticket Ticket
+	sent   time.Time
+}
+
+// NewTicketer returns a Ticketer sending to sink at most once per
+// fingerprint within window. Windows default to one hour.
+func NewTicketer(sink TicketSink, window time.Duration) *Ticketer {
+	if window <= 0 {
+		window = time.Hour
+	}
+	return &Ticketer{sink: sink, window: window, tickets: map[string]*ticketState{}}
+}
+
+// Report counts err and sends its ticket unless it was sent within the
+// dedup window. The count is kept when the sink fails. Nil errors are ignored.
+func (x *Ticketer) Report(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	now := time.Now()
+	fingerprint := Fingerprint(err)
+
+	x.mu.Lock()
+	state, ok := x.tickets[fingerprint]
+	if !ok {
+		info := ResolveChain(err)
+		state = &ticketState{ticket: Ticket{
+			Fingerprint: fingerprint,
+			Message:     info.Message,
+			Caller:      info.Caller,
+			Code:        info.Code,
+			Category:    info.Category,
+			Labels:      info.Labels,
+			FirstSeen:   now,
+		}}
+		x.tickets[fingerprint] = state
+	}
+	state.ticket.Count++
+	state.ticket.LastSeen = now
+	if !state.sent.IsZero() && now.Sub(state.sent) < x.window {
+		x.mu.Unlock()
+		return nil
+	}
+	t := state.ticket
+	state.ticket.Count = 0
+	state.sent = now
+	x.mu.Unlock()
+
+	if err := x.sink.Upsert(ctx, t); err != nil {
+		x.mu.Lock()
+		state.ticket.Count += t.Count
+		state.sent = time.Time{}
+		x.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// GitHubIssue returns the GitHub Issues API payload of t, labeled with
+// its fingerprint for finding the issue to update.
+func GitHubIssue(t Ticket) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"title":  t.Title(),
+		"body":   ticketBody(t),
+		"labels": []string{"errific:" + t.Fingerprint},
+	})
+}
+
+// JiraIssue returns a function building the Jira create issue payload
+// of a Ticket in project, labeled with its fingerprint.
+func JiraIssue(project, issueType string) func(Ticket) ([]byte, error) {
+	return func(t Ticket) ([]byte, error) {
+		return json.Marshal(map[string]any{
+			"fields": map[string]any{
+				"project":     map[string]string{"key": project},
+				"issuetype":   map[string]string{"name": issueType},
+				"summary":     t.Title(),
+				"description": ticketBody(t),
+				"labels":      []string{"errific-" + t.Fingerprint},
+			},
+		})
+	}
+}
+
+// ticketBody returns the Markdown description of t.
+func ticketBody(t Ticket) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", t.Message)
+	fmt.Fprintf(&b, "- fingerprint: `%s`\n", t.Fingerprint)
+	if t.Code != "" {
+		fmt.Fprintf(&b, "- code: `%s`\n", t.Code)
+	}
+	if t.Category != "" {
+		fmt.Fprintf(&b, "- category: %s\n", t.Category)
+	}
+	if t.Caller != "" {
+		fmt.Fprintf(&b, "- caller: `%s`\n", t.Caller)
+	}
+	fmt.Fprintf(&b, "- occurrences: %d between %s and %s\n",
+		t.Count, t.FirstSeen.UTC().Format(time.RFC3339), t.LastSeen.UTC().Format(time.RFC3339))
+	for _, k := range sortedKeys(t.Labels) {
+		fmt.Fprintf(&b, "- %s: %s\n", k, t.Labels[k])
+	}
+	return b.String()
+}
+
+// WebhookTicketSink returns a TicketSink posting the payload built from
+// tickets to url, for issue trackers or automation that upsert tickets by
+// fingerprint. Nil clients use http.DefaultClient.
+func WebhookTicketSink(url string, build func(Ticket) ([]byte, error), client *http.Client) TicketSink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return TicketSinkFunc(func(ctx context.Context, t Ticket) error {
+		body, err := build(t)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("errific: ticket webhook %s: %s", url, resp.Status)
+		}
+		return nil
+	})
+}
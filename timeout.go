@@ -0,0 +1,51 @@
+package errific
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTimeout is the Err of errors returned by WithTimeout.
+var ErrTimeout Err = "operation timed out"
+
+// Context keys set by WithTimeout.
+const (
+	ContextTimeoutOperation = "timeout_operation"
+	ContextTimeoutDeadline  = "timeout_deadline"
+	ContextTimeoutElapsed   = "timeout_elapsed"
+)
+
+// WithTimeout runs fn with a context that expires after d. If fn returns an
+// error once the deadline is exceeded, WithTimeout returns an ErrTimeout
+// error wrapping it, with CategoryTimeout, marked retryable, and the
+// operation name, deadline, and elapsed duration in its context.
+// Other errors from fn are returned as is.
+//
+// fn runs on the calling goroutine and must return when its context is done.
+//
+//	err := errific.WithTimeout(ctx, "fetch thing", 5*time.Second, func(ctx context.Context) error {
+//		return client.Fetch(ctx, id)
+//	})
+func WithTimeout(ctx context.Context, operation string, d time.Duration, fn func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	start := time.Now()
+	err := fn(ctx)
+	elapsed := time.Since(start)
+
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	deadline, _ := ctx.Deadline()
+	return ErrTimeout.newAt(1, err).
+		WithCategory(CategoryTimeout).
+		WithRetryable(true).
+		WithContext(map[string]any{
+			ContextTimeoutOperation: operation,
+			ContextTimeoutDeadline:  deadline,
+			ContextTimeoutElapsed:   elapsed,
+		})
+}
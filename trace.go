@@ -0,0 +1,79 @@
+package errific
+
+import (
+	"context"
+	"runtime/trace"
+	"sync/atomic"
+)
+
+// Context keys set by WithTrace.
+const (
+	ContextTraceTaskID = "trace_task_id"
+	ContextTraceTask   = "trace_task"
+	ContextTraceRegion = "trace_region"
+)
+
+// TraceCategory is the runtime/trace log category of error events.
+const TraceCategory = "errific"
+
+type traceTaskKey struct{}
+
+type traceRegionKey struct{}
+
+type traceTask struct {
+	id       uint64
+	taskType string
+}
+
+var traceTaskID atomic.Uint64
+
+// TraceTask starts a runtime/trace task like trace.NewTask,
+// remembering its ID and type in ctx for WithTrace.
+// runtime/trace does not expose task IDs, so IDs are assigned by errific.
+//
+//	ctx, task := errific.TraceTask(ctx, "processThing")
+//	defer task.End()
+func TraceTask(ctx context.Context, taskType string) (context.Context, *trace.Task) {
+	ctx, task := trace.NewTask(ctx, taskType)
+	t := traceTask{id: traceTaskID.Add(1), taskType: taskType}
+	return context.WithValue(ctx, traceTaskKey{}, t), task
+}
+
+// TraceRegion runs fn in a runtime/trace region like trace.WithRegion,
+// remembering the region type in the ctx passed to fn for WithTrace.
+//
+//	errific.TraceRegion(ctx, "query", func(ctx context.Context) {
+//		err = ErrQuery.New(err).WithTrace(ctx)
+//	})
+func TraceRegion(ctx context.Context, regionType string, fn func(context.Context)) {
+	ctx = context.WithValue(ctx, traceRegionKey{}, regionType)
+	trace.WithRegion(ctx, regionType, func() {
+		fn(ctx)
+	})
+}
+
+// WithTrace records the task and region of ctx started with TraceTask and
+// TraceRegion in the context of the error. When tracing is enabled
+// the error message is also logged as a trace event in TraceCategory,
+// so go tool trace timelines show where errors occurred.
+//
+//	return ErrProcessThing.New(err).WithTrace(ctx)
+func (e errific) WithTrace(ctx context.Context) errific {
+	fields := map[string]any{}
+	if t, ok := ctx.Value(traceTaskKey{}).(traceTask); ok {
+		fields[ContextTraceTaskID] = t.id
+		fields[ContextTraceTask] = t.taskType
+	}
+	if region, ok := ctx.Value(traceRegionKey{}).(string); ok {
+		fields[ContextTraceRegion] = region
+	}
+	if len(fields) > 0 {
+		e = e.WithContext(fields)
+	}
+
+	if trace.IsEnabled() {
+		trace.Log(ctx, TraceCategory, mask(e.err.Error()))
+	}
+
+	return e
+}
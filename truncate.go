@@ -0,0 +1,126 @@
+package errific
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Ellipsis joins the head and tail of truncated strings.
+const Ellipsis = "…"
+
+// Truncate returns s cut to at most n bytes, keeping its beginning and
+// end joined by Ellipsis, as "begin…end". Cuts are on rune boundaries so
+// multi-byte characters are never split and valid UTF-8 stays valid.
+//
+//	msg = errific.Truncate(msg, 256)
+func Truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	if n < len(Ellipsis) {
+		return s[:runeStart(s, n)]
+	}
+
+	n -= len(Ellipsis)
+	head := runeStart(s, n-n/2)
+	tail := runeEnd(s, len(s)-n/2)
+	return s[:head] + Ellipsis + s[tail:]
+}
+
+// TruncateWidth returns s cut to at most width terminal columns, like
+// Truncate. Wide east asian characters are two columns and combining
+// marks are zero.
+//
+//	fmt.Println(errific.TruncateWidth(err.Error(), 80))
+func TruncateWidth(s string, width int) string {
+	if Width(s) <= width {
+		return s
+	}
+	if width < 1 {
+		return ""
+	}
+
+	width--
+	headWidth, tailWidth := width-width/2, width/2
+
+	head := 0
+	for w := 0; head < len(s); {
+		r, size := utf8.DecodeRuneInString(s[head:])
+		if w+runeWidth(r) > headWidth {
+			break
+		}
+		w += runeWidth(r)
+		head += size
+	}
+
+	tail := len(s)
+	for w := 0; tail > head; {
+		r, size := utf8.DecodeLastRuneInString(s[:tail])
+		if w+runeWidth(r) > tailWidth {
+			break
+		}
+		w += runeWidth(r)
+		tail -= size
+	}
+
+	return s[:head] + Ellipsis + s[tail:]
+}
+
+// Width returns the terminal columns of s.
+func Width(s string) (width int) {
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// runeStart returns the rune boundary at or before i.
+func runeStart(s string, i int) int {
+	for i > 0 && i < len(s) && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
+
+// runeEnd returns the rune boundary at or after i.
+func runeEnd(s string, i int) int {
+	for i < len(s) && !utf8.RuneStart(s[i]) {
+		i++
+	}
+	return i
+}
+
+// wide are the east asian wide and fullwidth ranges, and emoji.
+var wide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33ff, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1},
+		{Lo: 0xa000, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe4f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+}
+
+// runeWidth returns the terminal columns of r.
+func runeWidth(r rune) int {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case unicode.Is(wide, r):
+		return 2
+	default:
+		return 1
+	}
+}
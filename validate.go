@@ -0,0 +1,61 @@
+package errific
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Issue is inconsistent metadata of an error found by Validate.
+type Issue struct {
+	Field   Field  `json:"field"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	return string(i.Field) + ": " + i.Message
+}
+
+// Validate returns the inconsistent metadata of the err chain as resolved
+// by ResolveChain, so CI and runtime hooks can catch nonsensical
+// combinations, such as a retry delay on an error that is not retryable,
+// or a success HTTP status for a server error. Nil errors have no issues.
+//
+//	for _, issue := range errific.Validate(err) {
+//		t.Error(issue)
+//	}
+func Validate(err error) []Issue {
+	if err == nil {
+		return nil
+	}
+
+	info := ResolveChain(err)
+	var issues []Issue
+	issue := func(field Field, format string, a ...any) {
+		issues = append(issues, Issue{Field: field, Message: fmt.Sprintf(format, a...)})
+	}
+
+	if info.RetryAfter != 0 && !info.Retryable {
+		issue(FieldRetryAfter, "retry after %s, but not retryable", info.RetryAfter)
+	}
+	if info.RetryAfter < 0 {
+		issue(FieldRetryAfter, "negative retry after %s", info.RetryAfter)
+	}
+
+	status := info.HTTPStatus
+	switch {
+	case status == 0:
+	case status < 100 || status > 599:
+		issue(FieldHTTPStatus, "invalid HTTP status %d", status)
+	case status < http.StatusBadRequest:
+		issue(FieldHTTPStatus, "non-error HTTP status %d", status)
+	case status < http.StatusInternalServerError && info.Category == CategoryServer:
+		issue(FieldHTTPStatus, "client HTTP status %d with category %s", status, info.Category)
+	case status >= http.StatusInternalServerError && (info.Category == CategoryClient || info.Category == CategoryValidation):
+		issue(FieldHTTPStatus, "server HTTP status %d with category %s", status, info.Category)
+	}
+
+	if d := info.Deprecation; d != nil && d.Replacement == info.Code && info.Code != "" {
+		issue(FieldDeprecation, "code %s is deprecated in favor of itself", info.Code)
+	}
+	return issues
+}
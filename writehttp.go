@@ -0,0 +1,109 @@
+package errific
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type view int
+
+const (
+	// ViewExternal writes the message, code, category, IDs, and retry
+	// metadata, replacing the message of 5xx errors with the status text
+	// so internal details are not exposed to clients. This is default.
+	ViewExternal view = iota
+	// ViewInternal writes the resolved metadata of the error chain.
+	ViewInternal
+	// ViewVerbose writes the JSON of the error, with its stack and wrapped errors.
+	ViewVerbose
+)
+
+type httpViewOption view
+
+func (httpViewOption) ErrificOption() {}
+
+var (
+	// HTTPView of errors written by WriteHTTP, ViewExternal|ViewInternal|ViewVerbose.
+	//
+	//	errific.Configure(errific.HTTPView(errific.ViewInternal))
+	HTTPView = func(v view) httpViewOption {
+		return httpViewOption(v)
+	}
+)
+
+// WriteHTTP writes err as a JSON response with the status of MapHTTPStatus,
+// the headers of EncodeHeader and RateLimitHeader, and a body of the
+// configured HTTPView.
+//
+//	if err != nil {
+//		errific.WriteHTTP(w, err)
+//		return
+//	}
+func WriteHTTP(w http.ResponseWriter, err error) {
+	status, header, body, marshalErr := EncodeHTTP(err)
+	if marshalErr != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	for k, v := range header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(status)
+	w.Write(append(body, '\n'))
+}
+
+// EncodeHTTP returns the status, headers, and body WriteHTTP writes for
+// err, for frameworks not writing to an http.ResponseWriter.
+//
+//	status, header, body, err := errific.EncodeHTTP(err)
+func EncodeHTTP(err error) (status int, header http.Header, body []byte, marshalErr error) {
+	status = MapHTTPStatus(err)
+	body, marshalErr = httpBody(err, status)
+	if marshalErr != nil {
+		return 0, nil, nil, marshalErr
+	}
+
+	header = http.Header{}
+	writeHTTPHeader(header, err)
+	header.Set("Content-Type", "application/json")
+	return status, header, body, nil
+}
+
+// writeHTTPHeader sets the headers of EncodeHeader and RateLimitHeader
+// for err in h.
+func writeHTTPHeader(h http.Header, err error) {
+	for k, v := range EncodeHeader(err) {
+		h[k] = v
+	}
+	rateLimitHeader(h, err)
+}
+
+// httpBody returns the JSON of err in the configured HTTPView.
+func httpBody(err error, status int) ([]byte, error) {
+	switch c.httpView {
+	case ViewVerbose:
+		if m, ok := err.(json.Marshaler); ok {
+			return m.MarshalJSON()
+		}
+		return marshalErrorJSON(&errorJSON{ErrorInfo: ResolveChain(err)})
+
+	case ViewInternal:
+		return marshalErrorJSON(&errorJSON{ErrorInfo: ResolveChain(err)})
+	}
+
+	info := ResolveChain(err)
+	external := ErrorInfo{
+		Message:       info.Message,
+		Code:          info.Code,
+		Category:      info.Category,
+		CorrelationID: info.CorrelationID,
+		RequestID:     info.RequestID,
+		Retryable:     info.Retryable,
+		RetryAfter:    info.RetryAfter,
+	}
+	if status >= http.StatusInternalServerError {
+		external.Message = http.StatusText(status)
+	}
+	return json.Marshal(external)
+}